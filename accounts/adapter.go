@@ -16,6 +16,11 @@ type AdapterL1 interface {
 	MainContract(ctx context.Context) (*zksync.IZkSync, error)
 	// L1BridgeContracts returns L1 bridge contracts.
 	L1BridgeContracts(ctx context.Context) (*zkTypes.L1BridgeContracts, error)
+	// BaseToken returns the L1 address of the token used to pay fees on L2 network, which is
+	// utils.EthAddress on ETH-based chains.
+	BaseToken(ctx context.Context) (common.Address, error)
+	// IsEthBasedChain returns whether ETH is the base token of L2 network.
+	IsEthBasedChain(ctx context.Context) (bool, error)
 	// BalanceL1 returns the balance of the specified token on L1 that can be
 	// either ETH or any ERC20 token.
 	BalanceL1(opts *CallOpts, token common.Address) (*big.Int, error)
@@ -25,7 +30,7 @@ type AdapterL1 interface {
 	L2TokenAddress(ctx context.Context, token common.Address) (common.Address, error)
 	// ApproveERC20 approves the specified amount of tokens for the specified L1 bridge.
 	ApproveERC20(auth *TransactOpts, token common.Address, amount *big.Int, bridgeAddress common.Address) (*types.Transaction, error)
-	// BaseCost returns base cost for L2 transaction.
+	// BaseCost returns base cost for L2 transaction, denominated in the base token.
 	BaseCost(opts *CallOpts, gasLimit, gasPerPubdataByte, gasPrice *big.Int) (*big.Int, error)
 	// Deposit transfers the specified token from the associated account on the L1 network
 	// to the target account on the L2 network. The token can be either ETH or any ERC20 token.
@@ -34,16 +39,30 @@ type AdapterL1 interface {
 	// DepositTransaction.ApproveERC20 can be enabled to perform token approval.
 	// If there are already enough approved tokens for the L1 bridge, token approval will be skipped.
 	// To check the amount of approved tokens for a specific bridge, use the AdapterL1.AllowanceL1 method.
-	Deposit(auth *TransactOpts, tx DepositTransaction) (*types.Transaction, error)
+	// The returned handle links the L1 transaction to the priority transaction on L2 network and tracks
+	// the status of the deposit.
+	// On chains whose base token is not ETH, the deposit is requested via the Bridgehub and the base cost is
+	// paid in the base token, which must be approved for the shared bridge. In this case,
+	// DepositTransaction.ApproveBaseERC20 can be enabled to perform base token approval.
+	Deposit(auth *TransactOpts, tx DepositTransaction) (*DepositHandle, error)
 	// EstimateGasDeposit estimates the amount of gas required for a deposit transaction on L1 network.
 	// Gas of approving ERC20 token is not included in estimation.
 	EstimateGasDeposit(ctx context.Context, msg DepositCallMsg) (uint64, error)
-	// FullRequiredDepositFee retrieves the full needed ETH fee for the deposit on both L1 and L2 networks.
+	// FullRequiredDepositFee retrieves the full needed fee for the deposit on both L1 and L2 networks.
+	// The L2 fee is denominated in the base token.
 	FullRequiredDepositFee(ctx context.Context, msg DepositCallMsg) (*FullDepositFee, error)
 	// FinalizeWithdraw proves the inclusion of the L2 -> L1 withdrawal message.
 	FinalizeWithdraw(auth *TransactOpts, withdrawalHash common.Hash, index int) (*types.Transaction, error)
 	// IsWithdrawFinalized checks if the withdrawal finalized on L1 network.
 	IsWithdrawFinalized(opts *CallOpts, withdrawalHash common.Hash, index int) (bool, error)
+	// ProveL2MessageInclusion checks on L1 network that the message sent from L2 network via
+	// AdapterL2.SendMessageToL1 is included in the batch. The proof is retrieved using AdapterL2.L2MessageProof.
+	ProveL2MessageInclusion(opts *CallOpts, proof *L2MessageProof) (bool, error)
+	// VerifyL2MessageProof retrieves the proof of the message sent by the transaction via L1Messenger.sendToL1,
+	// such as a withdrawal, and verifies it locally before it is used on L1 network. It checks that the L2 -> L1 log
+	// commits to the message, that the Merkle proof of the log leads to the root returned by the node, and that
+	// the root matches the L2 logs root of the batch stored in the main contract.
+	VerifyL2MessageProof(opts *CallOpts, txHash common.Hash, index int) (*L2MessageProof, error)
 	// ClaimFailedDeposit withdraws funds from the initiated deposit, which failed when finalizing on L2.
 	// If the deposit L2 transaction has failed, it sends an L1 transaction calling ClaimFailedDeposit method
 	// of the L1 bridge, which results in returning L1 tokens back to the depositor, otherwise throws the error.
@@ -52,6 +71,11 @@ type AdapterL1 interface {
 	RequestExecute(auth *TransactOpts, tx RequestExecuteTransaction) (*types.Transaction, error)
 	// EstimateGasRequestExecute estimates the amount of gas required for a request execute transaction.
 	EstimateGasRequestExecute(ctx context.Context, msg RequestExecuteCallMsg) (uint64, error)
+	// ReplaceTransactionL1 resubmits the pending L1 transaction, such as a stuck FinalizeWithdraw or Approve,
+	// with the same nonce and the fees bumped by bumpPercent, or by DefaultFeeBumpPercent if bumpPercent is 0.
+	// The fees are not lower than the current fees of the L1 network. Priority requests, such as Deposit and
+	// RequestExecute, cannot be replaced, since their value covers the base cost at the original gas price.
+	ReplaceTransactionL1(ctx context.Context, hash common.Hash, bumpPercent uint64) (*types.Transaction, error)
 }
 
 // AdapterL2 is associated with an account and provides common operations on the
@@ -71,14 +95,23 @@ type AdapterL2 interface {
 	L2BridgeContracts(ctx context.Context) (*zkTypes.L2BridgeContracts, error)
 	// Withdraw initiates the withdrawal process which withdraws ETH or any ERC20
 	// token from the associated account on L2 network to the target account on L1
-	// network.
-	Withdraw(auth *TransactOpts, tx WithdrawalTransaction) (*types.Transaction, error)
+	// network. The returned handle tracks the status of the withdrawal and finalizes it
+	// once the withdrawal can be finalized.
+	Withdraw(auth *TransactOpts, tx WithdrawalTransaction) (*WithdrawalHandle, error)
+	// SendMessageToL1 sends the arbitrary message from the associated account to L1 network
+	// via L1Messenger.sendToL1. Once the batch containing the transaction is executed on L1 network,
+	// the inclusion of the message can be proven using AdapterL2.L2MessageProof. It returns the hash of
+	// the transaction.
+	SendMessageToL1(auth *TransactOpts, message []byte) (common.Hash, error)
+	// L2MessageProof returns the proof of inclusion of the message sent by the transaction via L1Messenger.sendToL1.
+	// The index is the position of the message among the messages sent by the transaction.
+	L2MessageProof(ctx context.Context, txHash common.Hash, index int) (*L2MessageProof, error)
 	// EstimateGasWithdraw estimates the amount of gas required for a withdrawal
 	// transaction.
 	EstimateGasWithdraw(ctx context.Context, msg WithdrawalCallMsg) (uint64, error)
 	// Transfer moves the ETH or any ERC20 token from the associated account to the
-	// target account.
-	Transfer(auth *TransactOpts, tx TransferTransaction) (*types.Transaction, error)
+	// target account. It returns the hash of the transaction.
+	Transfer(auth *TransactOpts, tx TransferTransaction) (common.Hash, error)
 	// EstimateGasTransfer estimates the amount of gas required for a transfer
 	// transaction.
 	EstimateGasTransfer(ctx context.Context, msg TransferCallMsg) (uint64, error)
@@ -103,6 +136,15 @@ type AdapterL2 interface {
 	// SendTransaction injects a transaction into the pending pool for execution. Any
 	// unset transaction fields are prepared using the PopulateTransaction method.
 	SendTransaction(ctx context.Context, tx *Transaction) (common.Hash, error)
+	// CancelTransaction cancels the pending transaction by replacing it with a zero-value transfer to
+	// the associated account, which has the same nonce. The fees are bumped by bumpPercent over the fees of
+	// the pending transaction, or by DefaultFeeBumpPercent if bumpPercent is 0.
+	CancelTransaction(ctx context.Context, hash common.Hash, bumpPercent uint64) (common.Hash, error)
+	// ReplaceTransaction resubmits the pending transaction with the same nonce and the fees bumped by bumpPercent,
+	// or by DefaultFeeBumpPercent if bumpPercent is 0. The network does not return the EIP-712 specific fields,
+	// so meta must be the metadata of the pending transaction, such as paymaster parameters and factory
+	// dependencies, or nil if the pending transaction was sent without it.
+	ReplaceTransaction(ctx context.Context, hash common.Hash, meta *zkTypes.Eip712Meta, bumpPercent uint64) (common.Hash, error)
 }
 
 // Deployer is associated with an account and provides deployment of smart contracts
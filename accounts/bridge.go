@@ -0,0 +1,152 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+	"sort"
+	"sync"
+)
+
+// Bridge represents the pair of L1 and L2 bridge contracts that bridge tokens between L1 and L2 networks.
+// It encapsulates the ABI of the bridge, so that the custom bridges whose ABI differs from the default
+// ERC20 bridge can be used for deposits and withdrawals.
+type Bridge interface {
+	// L1Address returns the address of the L1 bridge contract.
+	L1Address() common.Address
+	// L2Address returns the address of the L2 bridge contract.
+	L2Address() common.Address
+	// DepositCalldata returns the calldata of the deposit transaction that is sent to the L1 bridge.
+	DepositCalldata(tx *DepositTransaction) ([]byte, error)
+	// EstimateDepositL2Gas returns an estimation of L2 gas required to finalize the deposit sent
+	// from the provided address on L2 network.
+	EstimateDepositL2Gas(ctx context.Context, tx *DepositTransaction, from common.Address) (uint64, error)
+	// ParseWithdrawalMessage parses the L2 -> L1 message sent by the L2 bridge on withdrawal.
+	ParseWithdrawalMessage(message []byte) (*WithdrawalMessage, error)
+	// FinalizeWithdrawal finalizes the withdrawal on L1 network.
+	FinalizeWithdrawal(opts *bind.TransactOpts, params *FinalizeWithdrawalParams) (*types.Transaction, error)
+	// IsWithdrawalFinalized checks whether the withdrawal, identified by the L2 batch number and the
+	// index of the message in the batch, is finalized on L1 network.
+	IsWithdrawalFinalized(opts *bind.CallOpts, l2BatchNumber, l2MessageIndex *big.Int) (bool, error)
+	// ClaimFailedDeposit withdraws the funds of the deposit whose priority transaction failed on L2 network.
+	ClaimFailedDeposit(opts *bind.TransactOpts, params *ClaimFailedDepositParams) (*types.Transaction, error)
+}
+
+// WithdrawalMessage represents the L2 -> L1 message sent by the L2 bridge on withdrawal.
+type WithdrawalMessage struct {
+	L1Receiver common.Address // The address that receives the tokens on L1 network.
+	L1Token    common.Address // The address of the token on L1 network.
+	Amount     *big.Int       // The amount of the token.
+}
+
+// FinalizeWithdrawalParams contains the parameters for the withdrawal finalization on L1 network.
+type FinalizeWithdrawalParams struct {
+	L2BatchNumber     *big.Int   // The L2 batch number where the withdrawal was processed.
+	L2MessageIndex    *big.Int   // The position in the L2 logs Merkle tree of the withdrawal message.
+	L2TxNumberInBatch uint16     // The L2 transaction number in the batch, in which the withdrawal was sent.
+	Message           []byte     // The L2 -> L1 message sent by the L2 bridge.
+	Proof             [][32]byte // The Merkle proof of the inclusion of the message in the batch.
+}
+
+// ClaimFailedDepositParams contains the parameters for claiming the failed deposit on L1 network.
+type ClaimFailedDepositParams struct {
+	L2TxHash          common.Hash // The hash of the failed priority transaction.
+	L2Calldata        []byte      // The calldata of the failed priority transaction, which is sent to the L2 bridge.
+	L2BatchNumber     *big.Int    // The L2 batch number where the priority transaction was processed.
+	L2MessageIndex    *big.Int    // The position in the L2 logs Merkle tree of the transaction status log.
+	L2TxNumberInBatch uint16      // The L2 transaction number in the batch, in which the priority transaction was processed.
+	Proof             [][32]byte  // The Merkle proof of the inclusion of the transaction status log in the batch.
+}
+
+// BridgeRegistry maps the L1 tokens to the bridges that are used for their deposits, and the
+// bridge addresses to the bridges that are used for the finalization of withdrawals and the
+// claiming of failed deposits. Tokens that are not registered are bridged by the default bridge.
+// It is safe for concurrent use.
+type BridgeRegistry struct {
+	mu            sync.RWMutex
+	defaultBridge Bridge
+	byToken       map[common.Address]Bridge
+	byL1Address   map[common.Address]Bridge
+	byL2Address   map[common.Address]Bridge
+}
+
+// NewBridgeRegistry creates a new instance of BridgeRegistry with the default bridge.
+func NewBridgeRegistry(defaultBridge Bridge) *BridgeRegistry {
+	r := &BridgeRegistry{
+		byToken:     make(map[common.Address]Bridge),
+		byL1Address: make(map[common.Address]Bridge),
+		byL2Address: make(map[common.Address]Bridge),
+	}
+	r.SetDefault(defaultBridge)
+	return r
+}
+
+// Register registers the bridge and assigns the L1 tokens to it, overriding their previous assignment.
+// Registering the bridge without tokens makes it available only by its addresses.
+func (r *BridgeRegistry) Register(bridge Bridge, tokens ...common.Address) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byL1Address[bridge.L1Address()] = bridge
+	r.byL2Address[bridge.L2Address()] = bridge
+	for _, token := range tokens {
+		r.byToken[token] = bridge
+	}
+}
+
+// SetDefault registers the bridge and uses it for the tokens that are not assigned to any bridge.
+func (r *BridgeRegistry) SetDefault(bridge Bridge) {
+	r.Register(bridge)
+	r.mu.Lock()
+	r.defaultBridge = bridge
+	r.mu.Unlock()
+}
+
+// Default returns the default bridge.
+func (r *BridgeRegistry) Default() Bridge {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.defaultBridge
+}
+
+// ForToken returns the bridge of the L1 token, or the default bridge if the token is not assigned to any bridge.
+func (r *BridgeRegistry) ForToken(token common.Address) Bridge {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if bridge, ok := r.byToken[token]; ok {
+		return bridge
+	}
+	return r.defaultBridge
+}
+
+// ByL1Address returns the bridge whose L1 contract is at the provided address.
+func (r *BridgeRegistry) ByL1Address(address common.Address) (Bridge, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	bridge, ok := r.byL1Address[address]
+	return bridge, ok
+}
+
+// ByL2Address returns the bridge whose L2 contract is at the provided address.
+func (r *BridgeRegistry) ByL2Address(address common.Address) (Bridge, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	bridge, ok := r.byL2Address[address]
+	return bridge, ok
+}
+
+// L2Addresses returns the addresses of the L2 contracts of the registered bridges.
+func (r *BridgeRegistry) L2Addresses() []common.Address {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	addresses := make([]common.Address, 0, len(r.byL2Address))
+	for address := range r.byL2Address {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+	return addresses
+}
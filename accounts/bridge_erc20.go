@@ -0,0 +1,187 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1bridge"
+	"github.com/zksync-sdk/zksync2-go/contracts/l2bridge"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+)
+
+// Erc20Bridge implements the Bridge interface for the default ERC20 bridge, as well as for the custom
+// bridges that share its ABI.
+type Erc20Bridge struct {
+	l1Address common.Address
+	l2Address common.Address
+	l1Bridge  *l1bridge.IL1Bridge
+
+	clientL1 bind.ContractBackend
+	clientL2 *clients.Client
+}
+
+// NewErc20Bridge creates an instance of Erc20Bridge for the pair of L1 and L2 bridge contracts.
+func NewErc20Bridge(l1Address, l2Address common.Address, clientL1 bind.ContractBackend, clientL2 *clients.Client) (*Erc20Bridge, error) {
+	l1Bridge, err := l1bridge.NewIL1Bridge(l1Address, clientL1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IL1Bridge: %w", err)
+	}
+	return &Erc20Bridge{
+		l1Address: l1Address,
+		l2Address: l2Address,
+		l1Bridge:  l1Bridge,
+		clientL1:  clientL1,
+		clientL2:  clientL2,
+	}, nil
+}
+
+func (b *Erc20Bridge) L1Address() common.Address {
+	return b.l1Address
+}
+
+func (b *Erc20Bridge) L2Address() common.Address {
+	return b.l2Address
+}
+
+func (b *Erc20Bridge) DepositCalldata(tx *DepositTransaction) ([]byte, error) {
+	l1BridgeAbi, err := l1bridge.IL1BridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IL1Bridge ABI: %w", err)
+	}
+	return l1BridgeAbi.Pack("deposit", tx.To, tx.Token, tx.Amount, tx.L2GasLimit, tx.GasPerPubdataByte, tx.RefundRecipient)
+}
+
+// EstimateDepositL2Gas estimates the L2 gas of the deposit. If the CustomBridgeData of the deposit is not
+// provided, the data required for the initialization of the L2 token is used.
+func (b *Erc20Bridge) EstimateDepositL2Gas(ctx context.Context, tx *DepositTransaction, from common.Address) (uint64, error) {
+	bridgeData := tx.CustomBridgeData
+	if bridgeData == nil {
+		var err error
+		bridgeData, err = utils.Erc20DefaultBridgeData(tx.Token, b.clientL1)
+		if err != nil {
+			return 0, err
+		}
+	}
+	calldata, err := utils.Erc20BridgeCalldata(tx.Token, from, tx.To, tx.Amount, bridgeData)
+	if err != nil {
+		return 0, err
+	}
+	return b.estimateFinalizeDeposit(ctx, calldata, nil, tx.GasPerPubdataByte)
+}
+
+// ParseWithdrawalMessage parses the message, which is encoded as
+// abi.encodePacked(IL1Bridge.finalizeWithdrawal.selector, l1Receiver, l1Token, amount).
+func (b *Erc20Bridge) ParseWithdrawalMessage(message []byte) (*WithdrawalMessage, error) {
+	if err := checkWithdrawalMessage(message, 76); err != nil {
+		return nil, err
+	}
+	return &WithdrawalMessage{
+		L1Receiver: common.BytesToAddress(message[4:24]),
+		L1Token:    common.BytesToAddress(message[24:44]),
+		Amount:     new(big.Int).SetBytes(message[44:76]),
+	}, nil
+}
+
+func (b *Erc20Bridge) FinalizeWithdrawal(opts *bind.TransactOpts, params *FinalizeWithdrawalParams) (*types.Transaction, error) {
+	return b.l1Bridge.FinalizeWithdrawal(opts,
+		params.L2BatchNumber,
+		params.L2MessageIndex,
+		params.L2TxNumberInBatch,
+		params.Message,
+		params.Proof,
+	)
+}
+
+func (b *Erc20Bridge) IsWithdrawalFinalized(opts *bind.CallOpts, l2BatchNumber, l2MessageIndex *big.Int) (bool, error) {
+	return b.l1Bridge.IsWithdrawalFinalized(opts, l2BatchNumber, l2MessageIndex)
+}
+
+// ClaimFailedDeposit claims the failed deposit, whose depositor and token are decoded from the calldata of
+// the failed finalizeDeposit call on the L2 bridge.
+func (b *Erc20Bridge) ClaimFailedDeposit(opts *bind.TransactOpts, params *ClaimFailedDepositParams) (*types.Transaction, error) {
+	l2BridgeAbi, err := l2bridge.IL2BridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IL2Bridge ABI: %w", err)
+	}
+	calldata, err := unpackFinalizeDeposit(l2BridgeAbi, params.L2Calldata)
+	if err != nil {
+		return nil, err
+	}
+	l1Sender, ok := calldata[0].(common.Address)
+	if !ok {
+		return nil, errors.New("failed to parse l1Sender from unpacked calldata")
+	}
+	l1Token, ok := calldata[2].(common.Address)
+	if !ok {
+		return nil, errors.New("failed to parse l1Token from unpacked calldata")
+	}
+
+	return b.l1Bridge.ClaimFailedDeposit(opts,
+		l1Sender,
+		l1Token,
+		params.L2TxHash,
+		params.L2BatchNumber,
+		params.L2MessageIndex,
+		params.L2TxNumberInBatch,
+		params.Proof,
+	)
+}
+
+// estimateFinalizeDeposit estimates the L2 gas of the priority transaction that the L1 bridge sends to the L2 bridge.
+func (b *Erc20Bridge) estimateFinalizeDeposit(ctx context.Context, calldata []byte, value, gasPerPubdataByte *big.Int) (uint64, error) {
+	if gasPerPubdataByte == nil {
+		gasPerPubdataByte = utils.RequiredL1ToL2GasPerPubdataLimit
+	}
+	return (*b.clientL2).EstimateL1ToL2Execute(ensureContext(ctx), zkTypes.CallMsg{
+		CallMsg: ethereum.CallMsg{
+			From:  utils.ApplyL1ToL2Alias(b.l1Address),
+			To:    &b.l2Address,
+			Value: value,
+			Data:  calldata,
+		},
+		Meta: &zkTypes.Eip712Meta{
+			GasPerPubdata: utils.NewBig(gasPerPubdataByte.Int64()),
+		},
+	})
+}
+
+// unpackFinalizeDeposit unpacks the arguments of the finalizeDeposit call on the L2 bridge.
+func unpackFinalizeDeposit(l2BridgeAbi *abi.ABI, data []byte) ([]interface{}, error) {
+	method := l2BridgeAbi.Methods["finalizeDeposit"]
+	if len(data) < 4 || !bytes.Equal(data[:4], method.ID) {
+		return nil, errors.New("calldata is not a finalizeDeposit call")
+	}
+	calldata, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to Unpack finalizeDeposit data: %w", err)
+	}
+	if len(calldata) < 4 {
+		return nil, errors.New("unpacked calldata is empty")
+	}
+	return calldata, nil
+}
+
+// checkWithdrawalMessage checks that the withdrawal message has the expected length and starts with
+// the selector of the IL1Bridge.finalizeWithdrawal method.
+func checkWithdrawalMessage(message []byte, length int) error {
+	l1BridgeAbi, err := l1bridge.IL1BridgeMetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("failed to load IL1Bridge ABI: %w", err)
+	}
+	if len(message) != length {
+		return fmt.Errorf("invalid withdrawal message length: %d", len(message))
+	}
+	if !bytes.Equal(message[:4], l1BridgeAbi.Methods["finalizeWithdrawal"].ID) {
+		return errors.New("invalid withdrawal message selector")
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1sharedbridge"
+	"github.com/zksync-sdk/zksync2-go/contracts/l2bridge"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+)
+
+// SharedBridge implements the Bridge interface for the shared bridge, which bridges the tokens of all chains
+// connected to the Bridgehub, including ETH on chains whose base token is not ETH. The deposits through
+// the shared bridge are requested via the Bridgehub, so DepositCalldata is not supported.
+type SharedBridge struct {
+	*Erc20Bridge
+	l1SharedBridge *l1sharedbridge.IL1SharedBridge
+	chainID        *big.Int
+}
+
+// NewSharedBridge creates an instance of SharedBridge for the pair of L1 and L2 shared bridge contracts of the chain.
+func NewSharedBridge(l1Address, l2Address common.Address, chainID *big.Int, clientL1 bind.ContractBackend,
+	clientL2 *clients.Client) (*SharedBridge, error) {
+	erc20Bridge, err := NewErc20Bridge(l1Address, l2Address, clientL1, clientL2)
+	if err != nil {
+		return nil, err
+	}
+	l1SharedBridge, err := l1sharedbridge.NewIL1SharedBridge(l1Address, clientL1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IL1SharedBridge: %w", err)
+	}
+	return &SharedBridge{
+		Erc20Bridge:    erc20Bridge,
+		l1SharedBridge: l1SharedBridge,
+		chainID:        chainID,
+	}, nil
+}
+
+func (b *SharedBridge) DepositCalldata(_ *DepositTransaction) ([]byte, error) {
+	return nil, errors.New("deposits through the shared bridge must be requested via the Bridgehub")
+}
+
+// EstimateDepositL2Gas estimates the L2 gas of the deposit. ETH is bridged as utils.EthAddressInContracts.
+func (b *SharedBridge) EstimateDepositL2Gas(ctx context.Context, tx *DepositTransaction, from common.Address) (uint64, error) {
+	token := tx.Token
+	if token == utils.EthAddress {
+		token = utils.EthAddressInContracts
+	}
+	bridgeData := tx.CustomBridgeData
+	if bridgeData == nil {
+		var err error
+		bridgeData, err = utils.Erc20DefaultBridgeData(token, b.clientL1)
+		if err != nil {
+			return 0, err
+		}
+	}
+	calldata, err := utils.Erc20BridgeCalldata(token, from, tx.To, tx.Amount, bridgeData)
+	if err != nil {
+		return 0, err
+	}
+	return b.estimateFinalizeDeposit(ctx, calldata, nil, tx.GasPerPubdataByte)
+}
+
+func (b *SharedBridge) FinalizeWithdrawal(opts *bind.TransactOpts, params *FinalizeWithdrawalParams) (*types.Transaction, error) {
+	return b.l1SharedBridge.FinalizeWithdrawal(opts,
+		b.chainID,
+		params.L2BatchNumber,
+		params.L2MessageIndex,
+		params.L2TxNumberInBatch,
+		params.Message,
+		params.Proof,
+	)
+}
+
+func (b *SharedBridge) IsWithdrawalFinalized(opts *bind.CallOpts, l2BatchNumber, l2MessageIndex *big.Int) (bool, error) {
+	return b.l1SharedBridge.IsWithdrawalFinalized(opts, b.chainID, l2BatchNumber, l2MessageIndex)
+}
+
+// ClaimFailedDeposit claims the failed deposit, whose depositor, token and amount are decoded from the calldata
+// of the failed finalizeDeposit call on the L2 bridge.
+func (b *SharedBridge) ClaimFailedDeposit(opts *bind.TransactOpts, params *ClaimFailedDepositParams) (*types.Transaction, error) {
+	l2BridgeAbi, err := l2bridge.IL2BridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IL2Bridge ABI: %w", err)
+	}
+	calldata, err := unpackFinalizeDeposit(l2BridgeAbi, params.L2Calldata)
+	if err != nil {
+		return nil, err
+	}
+	l1Sender, ok := calldata[0].(common.Address)
+	if !ok {
+		return nil, errors.New("failed to parse l1Sender from unpacked calldata")
+	}
+	l1Token, ok := calldata[2].(common.Address)
+	if !ok {
+		return nil, errors.New("failed to parse l1Token from unpacked calldata")
+	}
+	amount, ok := calldata[3].(*big.Int)
+	if !ok {
+		return nil, errors.New("failed to parse amount from unpacked calldata")
+	}
+
+	return b.l1SharedBridge.ClaimFailedDeposit(opts,
+		b.chainID,
+		l1Sender,
+		l1Token,
+		amount,
+		params.L2TxHash,
+		params.L2BatchNumber,
+		params.L2MessageIndex,
+		params.L2TxNumberInBatch,
+		params.Proof,
+	)
+}
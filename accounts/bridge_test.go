@@ -0,0 +1,191 @@
+package accounts
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/zksync-sdk/zksync2-go/contracts/bridgehub"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1bridge"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+	"testing"
+)
+
+func TestBridgeRegistry(t *testing.T) {
+	defaultBridge := &Erc20Bridge{l1Address: common.HexToAddress("0x0a01"), l2Address: common.HexToAddress("0x0b01")}
+	customBridge := &Erc20Bridge{l1Address: common.HexToAddress("0x0a02"), l2Address: common.HexToAddress("0x0b02")}
+	token := common.HexToAddress("0x0c01")
+	registry := NewBridgeRegistry(defaultBridge)
+
+	assert.Equal(t, defaultBridge, registry.Default(), "Default bridge should be returned")
+	assert.Equal(t, defaultBridge, registry.ForToken(token), "Unregistered token should use default bridge")
+	bridge, ok := registry.ByL2Address(defaultBridge.L2Address())
+	assert.True(t, ok, "Default bridge should be registered by L2 address")
+	assert.Equal(t, defaultBridge, bridge, "Default bridge should be returned by L2 address")
+
+	registry.Register(customBridge, token)
+	assert.Equal(t, customBridge, registry.ForToken(token), "Registered token should use custom bridge")
+	bridge, ok = registry.ByL1Address(customBridge.L1Address())
+	assert.True(t, ok, "Custom bridge should be registered by L1 address")
+	assert.Equal(t, customBridge, bridge, "Custom bridge should be returned by L1 address")
+	_, ok = registry.ByL1Address(token)
+	assert.False(t, ok, "Unknown address should not be registered")
+	assert.Equal(t, []common.Address{defaultBridge.L2Address(), customBridge.L2Address()}, registry.L2Addresses(),
+		"L2 addresses of registered bridges should be returned")
+}
+
+func TestErc20BridgeParseWithdrawalMessage(t *testing.T) {
+	l1BridgeAbi, err := l1bridge.IL1BridgeMetaData.GetAbi()
+	assert.NoError(t, err, "GetAbi should not return error")
+	receiver := common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049")
+	token := common.HexToAddress("0x0c01")
+	amount := big.NewInt(1_000_000)
+
+	message := append([]byte{}, l1BridgeAbi.Methods["finalizeWithdrawal"].ID...)
+	message = append(message, receiver.Bytes()...)
+	message = append(message, token.Bytes()...)
+	message = append(message, common.BigToHash(amount).Bytes()...)
+
+	bridge := &Erc20Bridge{}
+	parsed, err := bridge.ParseWithdrawalMessage(message)
+	assert.NoError(t, err, "ParseWithdrawalMessage should not return error")
+	assert.Equal(t, &WithdrawalMessage{L1Receiver: receiver, L1Token: token, Amount: amount}, parsed, "Messages should be the same")
+
+	_, err = bridge.ParseWithdrawalMessage(message[:56])
+	assert.Error(t, err, "ParseWithdrawalMessage should return error for message of invalid length")
+	message[0] ^= 0xff
+	_, err = bridge.ParseWithdrawalMessage(message)
+	assert.Error(t, err, "ParseWithdrawalMessage should return error for message with invalid selector")
+}
+
+func TestWalletL1WithdrawalBridge(t *testing.T) {
+	l1BridgeAbi, err := l1bridge.IL1BridgeMetaData.GetAbi()
+	assert.NoError(t, err, "GetAbi should not return error")
+	bridge := &Erc20Bridge{l1Address: common.HexToAddress("0x0a01"), l2Address: common.HexToAddress("0x0b01")}
+	wallet := &WalletL1{bridges: NewBridgeRegistry(bridge)}
+
+	message := append([]byte{}, l1BridgeAbi.Methods["finalizeWithdrawal"].ID...)
+	message = append(message, common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049").Bytes()...)
+	message = append(message, common.HexToAddress("0x0c01").Bytes()...)
+	message = append(message, common.BigToHash(big.NewInt(1_000_000)).Bytes()...)
+	found, err := wallet.withdrawalBridge(context.Background(), &L2MessageProof{Sender: bridge.L2Address(), Message: message})
+	assert.NoError(t, err, "withdrawalBridge should not return error")
+	assert.Equal(t, bridge, found, "Bridges should be the same")
+
+	_, err = wallet.withdrawalBridge(context.Background(), &L2MessageProof{Sender: bridge.L2Address(), Message: message[:56]})
+	assert.Error(t, err, "withdrawalBridge should return error for message that is not sent by bridge")
+}
+
+func TestWethBridgeParseWithdrawalMessage(t *testing.T) {
+	// message sent by L2WethBridge.withdraw through L2EthToken.withdrawWithMessage
+	message := common.FromHex("0x6c0960f9" +
+		"0000000000000000000000000000000000000e01" +
+		"00000000000000000000000000000000000000000000000000000000000f4240" +
+		"0000000000000000000000000000000000000e02" +
+		"36615cf349d7f6344891b1e7ca7c72883f5dc049")
+	receiver := common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049")
+	weth := common.HexToAddress("0x0e03")
+	bridge := &WethBridge{
+		Erc20Bridge:   &Erc20Bridge{l1Address: common.HexToAddress("0x0e01"), l2Address: common.HexToAddress("0x0e02")},
+		l1WethAddress: weth,
+	}
+
+	parsed, err := bridge.ParseWithdrawalMessage(message)
+	assert.NoError(t, err, "ParseWithdrawalMessage should not return error")
+	assert.Equal(t, &WithdrawalMessage{L1Receiver: receiver, L1Token: weth, Amount: big.NewInt(1_000_000)}, parsed, "Messages should be the same")
+
+	_, err = bridge.ParseWithdrawalMessage(message[:56])
+	assert.Error(t, err, "ParseWithdrawalMessage should return error for ETH withdrawal message")
+	otherBridge := &WethBridge{Erc20Bridge: &Erc20Bridge{l1Address: common.HexToAddress("0x0f01")}}
+	_, err = otherBridge.ParseWithdrawalMessage(message)
+	assert.Error(t, err, "ParseWithdrawalMessage should return error for message of other bridge")
+
+	wallet := &WalletL1{bridges: NewBridgeRegistry(&Erc20Bridge{l1Address: common.HexToAddress("0x0a01")})}
+	wallet.bridges.Register(bridge, weth)
+	found, ok := wallet.wethBridgeByEthWithdrawal(message)
+	assert.True(t, ok, "WETH withdrawal should be finalized by WETH bridge")
+	assert.Equal(t, bridge, found, "Bridges should be the same")
+	ethMessage := append(append(append([]byte{}, message[:4]...), receiver.Bytes()...), message[24:56]...)
+	_, ok = wallet.wethBridgeByEthWithdrawal(ethMessage)
+	assert.False(t, ok, "ETH withdrawal should not be finalized by WETH bridge")
+
+	_, err = bridge.ClaimFailedDeposit(nil, &ClaimFailedDepositParams{})
+	assert.Error(t, err, "ClaimFailedDeposit should return error for WETH bridge")
+}
+
+func TestErc20BridgeDepositCalldata(t *testing.T) {
+	l1BridgeAbi, err := l1bridge.IL1BridgeMetaData.GetAbi()
+	assert.NoError(t, err, "GetAbi should not return error")
+	tx := &DepositTransaction{
+		To:                common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049"),
+		Token:             common.HexToAddress("0x0c01"),
+		Amount:            big.NewInt(5),
+		L2GasLimit:        big.NewInt(300_000),
+		GasPerPubdataByte: big.NewInt(800),
+		RefundRecipient:   common.HexToAddress("0x0d01"),
+	}
+
+	calldata, err := (&Erc20Bridge{}).DepositCalldata(tx)
+	assert.NoError(t, err, "DepositCalldata should not return error")
+	method := l1BridgeAbi.Methods["deposit"]
+	assert.Equal(t, method.ID, calldata[:4], "Calldata should call deposit")
+	args, err := method.Inputs.Unpack(calldata[4:])
+	assert.NoError(t, err, "Unpack should not return error")
+	assert.Equal(t, []interface{}{tx.To, tx.Token, tx.Amount, tx.L2GasLimit, tx.GasPerPubdataByte, tx.RefundRecipient}, args, "Arguments should be the same")
+
+	_, err = (&Erc20Bridge{}).ClaimFailedDeposit(nil, &ClaimFailedDepositParams{L2Calldata: calldata})
+	assert.Error(t, err, "ClaimFailedDeposit should return error if calldata is not finalizeDeposit call")
+}
+
+func TestBridgehubDepositCalldata(t *testing.T) {
+	bridgehubAbi, err := bridgehub.IBridgehubMetaData.GetAbi()
+	assert.NoError(t, err, "GetAbi should not return error")
+	wallet := &WalletL1{
+		chainID:             big.NewInt(270),
+		baseToken:           common.HexToAddress("0x0f01"),
+		sharedBridgeAddress: common.HexToAddress("0x0a03"),
+	}
+	tx := &DepositTransaction{
+		To:                common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049"),
+		Token:             utils.EthAddress,
+		Amount:            big.NewInt(5),
+		L2GasLimit:        big.NewInt(300_000),
+		GasPerPubdataByte: big.NewInt(800),
+		RefundRecipient:   common.HexToAddress("0x0d01"),
+	}
+	deposit := &bridgehubDeposit{tx: tx, mintValue: big.NewInt(1_000)}
+
+	calldata, err := wallet.bridgehubDepositCalldata(deposit)
+	assert.NoError(t, err, "bridgehubDepositCalldata should not return error")
+	method := bridgehubAbi.Methods["requestL2TransactionTwoBridges"]
+	assert.Equal(t, method.ID, calldata[:4], "Calldata should call requestL2TransactionTwoBridges")
+	args, err := method.Inputs.Unpack(calldata[4:])
+	assert.NoError(t, err, "Unpack should not return error")
+	request := *abi.ConvertType(args[0], new(bridgehub.L2TransactionRequestTwoBridgesOuter)).(*bridgehub.L2TransactionRequestTwoBridgesOuter)
+	assert.Zero(t, request.L2Value.Sign(), "L2 value should be zero")
+	request.L2Value = big.NewInt(0)
+	secondBridgeCalldata, err := sharedBridgeDepositData(utils.EthAddressInContracts, big.NewInt(0), tx.To)
+	assert.NoError(t, err, "sharedBridgeDepositData should not return error")
+	assert.Equal(t, bridgehub.L2TransactionRequestTwoBridgesOuter{
+		ChainId:                  wallet.chainID,
+		MintValue:                deposit.mintValue,
+		L2Value:                  big.NewInt(0),
+		L2GasLimit:               tx.L2GasLimit,
+		L2GasPerPubdataByteLimit: tx.GasPerPubdataByte,
+		RefundRecipient:          tx.RefundRecipient,
+		SecondBridgeAddress:      wallet.sharedBridgeAddress,
+		SecondBridgeValue:        tx.Amount,
+		SecondBridgeCalldata:     secondBridgeCalldata,
+	}, request, "ETH should be deposited through the shared bridge")
+
+	tx.Token = wallet.baseToken
+	calldata, err = wallet.bridgehubDepositCalldata(deposit)
+	assert.NoError(t, err, "bridgehubDepositCalldata should not return error")
+	assert.Equal(t, bridgehubAbi.Methods["requestL2TransactionDirect"].ID, calldata[:4], "Base token should be deposited directly")
+}
+
+func TestSharedBridgeDepositCalldata(t *testing.T) {
+	_, err := (&SharedBridge{Erc20Bridge: &Erc20Bridge{}}).DepositCalldata(&DepositTransaction{})
+	assert.Error(t, err, "DepositCalldata should return error for shared bridge")
+}
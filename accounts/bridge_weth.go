@@ -0,0 +1,91 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1wethbridge"
+	"github.com/zksync-sdk/zksync2-go/contracts/zksync"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+)
+
+// WethBridge implements the Bridge interface for the WETH bridge. The WETH bridge unwraps the deposited
+// WETH and passes it to L2 network as ETH, and it refunds the failed deposits on L2 network instead of
+// allowing them to be claimed on L1 network.
+type WethBridge struct {
+	*Erc20Bridge
+	l1WethBridge  *l1wethbridge.IL1WethBridge
+	l1WethAddress common.Address
+}
+
+// NewWethBridge creates an instance of WethBridge for the pair of L1 and L2 WETH bridge contracts.
+func NewWethBridge(l1Address, l2Address common.Address, clientL1 bind.ContractBackend, clientL2 *clients.Client) (*WethBridge, error) {
+	erc20Bridge, err := NewErc20Bridge(l1Address, l2Address, clientL1, clientL2)
+	if err != nil {
+		return nil, err
+	}
+	l1WethBridge, err := l1wethbridge.NewIL1WethBridge(l1Address, clientL1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IL1WethBridge: %w", err)
+	}
+	l1WethAddress, err := l1WethBridge.L1WethAddress(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L1 WETH address: %w", err)
+	}
+	return &WethBridge{
+		Erc20Bridge:   erc20Bridge,
+		l1WethBridge:  l1WethBridge,
+		l1WethAddress: l1WethAddress,
+	}, nil
+}
+
+// L1WethAddress returns the address of the WETH token on L1 network.
+func (b *WethBridge) L1WethAddress() common.Address {
+	return b.l1WethAddress
+}
+
+// EstimateDepositL2Gas estimates the L2 gas of the deposit. The CustomBridgeData of the deposit is ignored,
+// since the WETH bridge always sends empty bridge data along with the unwrapped ETH.
+func (b *WethBridge) EstimateDepositL2Gas(ctx context.Context, tx *DepositTransaction, from common.Address) (uint64, error) {
+	calldata, err := utils.Erc20BridgeCalldata(tx.Token, from, tx.To, tx.Amount, []byte{})
+	if err != nil {
+		return 0, err
+	}
+	return b.estimateFinalizeDeposit(ctx, calldata, tx.Amount, tx.GasPerPubdataByte)
+}
+
+// ParseWithdrawalMessage parses the message, which is sent by the L2 ETH token on behalf of the L2 WETH bridge
+// once the withdrawn WETH is burned, and is encoded as abi.encodePacked(IZkSync.finalizeEthWithdrawal.selector,
+// l1WethBridge, amount, l2WethBridge, l1Receiver).
+func (b *WethBridge) ParseWithdrawalMessage(message []byte) (*WithdrawalMessage, error) {
+	zkSyncAbi, err := zksync.IZkSyncMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IZkSync ABI: %w", err)
+	}
+	if len(message) != 96 {
+		return nil, fmt.Errorf("invalid withdrawal message length: %d", len(message))
+	}
+	if !bytes.Equal(message[:4], zkSyncAbi.Methods["finalizeEthWithdrawal"].ID) {
+		return nil, errors.New("invalid withdrawal message selector")
+	}
+	if common.BytesToAddress(message[4:24]) != b.L1Address() || common.BytesToAddress(message[56:76]) != b.L2Address() {
+		return nil, errors.New("withdrawal message is not sent by the WETH bridge")
+	}
+	return &WithdrawalMessage{
+		L1Receiver: common.BytesToAddress(message[76:96]),
+		L1Token:    b.l1WethAddress,
+		Amount:     new(big.Int).SetBytes(message[24:56]),
+	}, nil
+}
+
+// ClaimFailedDeposit always returns an error, since the WETH bridge refunds the failed deposits to
+// the refund recipient on L2 network.
+func (b *WethBridge) ClaimFailedDeposit(_ *bind.TransactOpts, _ *ClaimFailedDepositParams) (*types.Transaction, error) {
+	return nil, errors.New("failed WETH deposit can't be claimed, the funds are refunded to the L2 refund recipient")
+}
@@ -0,0 +1,195 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"sync"
+)
+
+// DepositStatus represents the stage of the deposit process.
+type DepositStatus uint8
+
+const (
+	// DepositPendingL1 means that the deposit transaction is not yet mined on L1.
+	DepositPendingL1 DepositStatus = iota
+	// DepositAwaitingL2 means that the deposit transaction is mined on L1, but the corresponding
+	// priority transaction is not yet executed on L2.
+	DepositAwaitingL2
+	// DepositSucceeded means that the priority transaction is successfully executed on L2.
+	DepositSucceeded
+	// DepositClaimable means that the priority transaction failed on L2 and the deposited tokens
+	// can be claimed back on L1 using DepositHandle.ClaimFailed.
+	DepositClaimable
+	// DepositFailed means that either the deposit transaction failed on L1, or the priority transaction
+	// of the ETH or WETH deposit failed on L2, in which case the deposited ETH is refunded on L2.
+	DepositFailed
+)
+
+func (s DepositStatus) String() string {
+	switch s {
+	case DepositPendingL1:
+		return "pending on L1"
+	case DepositAwaitingL2:
+		return "awaiting L2"
+	case DepositSucceeded:
+		return "succeeded"
+	case DepositClaimable:
+		return "claimable"
+	case DepositFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// DepositHandle links the deposit transaction on L1 network to the priority transaction that finalizes
+// the deposit on L2 network, and tracks the deposit through the stages reported by DepositStatus.
+// It embeds the L1 deposit transaction, so it can be used wherever the transaction is expected.
+type DepositHandle struct {
+	*types.Transaction
+	Token common.Address // The deposited token.
+
+	clientL1  bind.DeployBackend
+	clientL2  *clients.Client
+	adapterL1 AdapterL1
+	// refundedOnL2 is set if the bridge refunds the failed deposit on L2 instead of allowing it to be claimed on L1.
+	refundedOnL2 bool
+
+	mu     sync.Mutex
+	l2Hash *common.Hash
+}
+
+// NewDepositHandle creates a new instance of DepositHandle for the deposit transaction of the token.
+// The adapterL1 is optional; if it is not provided, DepositHandle.ClaimFailed returns an error.
+// The failed deposit is reported as DepositClaimable, since the handle does not know whether the value
+// is refunded on L2 instead; the handles returned by AdapterL1.Deposit report such deposits as DepositFailed.
+func NewDepositHandle(tx *types.Transaction, token common.Address, clientL1 bind.DeployBackend, clientL2 *clients.Client, adapterL1 AdapterL1) *DepositHandle {
+	return &DepositHandle{
+		Transaction: tx,
+		Token:       token,
+		clientL1:    clientL1,
+		clientL2:    clientL2,
+		adapterL1:   adapterL1,
+	}
+}
+
+// WaitL1 waits for the deposit transaction to be mined on L1 and returns its receipt.
+func (h *DepositHandle) WaitL1(ctx context.Context) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ensureContext(ctx), h.clientL1, h.Transaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for L1 transaction: %w", err)
+	}
+	return receipt, nil
+}
+
+// L2Hash waits for the deposit transaction to be mined on L1 and returns the hash of the corresponding
+// priority transaction on L2.
+func (h *DepositHandle) L2Hash(ctx context.Context) (common.Hash, error) {
+	h.mu.Lock()
+	cached := h.l2Hash
+	h.mu.Unlock()
+	if cached != nil {
+		return *cached, nil
+	}
+
+	receipt, err := h.WaitL1(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return h.l2HashFromReceipt(ensureContext(ctx), receipt)
+}
+
+// WaitL2 waits for the priority transaction to be executed on L2 and returns its receipt.
+// The receipt is returned even if the priority transaction failed.
+func (h *DepositHandle) WaitL2(ctx context.Context) (*zkTypes.Receipt, error) {
+	l2Hash, err := h.L2Hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := (*h.clientL2).WaitMined(ensureContext(ctx), l2Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for L2 transaction: %w", err)
+	}
+	return receipt, nil
+}
+
+// Status returns the current stage of the deposit.
+func (h *DepositHandle) Status(ctx context.Context) (DepositStatus, error) {
+	ctx = ensureContext(ctx)
+	receiptL1, err := h.clientL1.TransactionReceipt(ctx, h.Hash())
+	if errors.Is(err, ethereum.NotFound) {
+		return DepositPendingL1, nil
+	}
+	if err != nil {
+		return DepositPendingL1, fmt.Errorf("failed to get L1 transaction receipt: %w", err)
+	}
+	if receiptL1.Status == types.ReceiptStatusFailed {
+		return DepositFailed, nil
+	}
+
+	l2Hash, err := h.l2HashFromReceipt(ctx, receiptL1)
+	if err != nil {
+		return DepositAwaitingL2, err
+	}
+	receiptL2, err := (*h.clientL2).TransactionReceipt(ctx, l2Hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return DepositAwaitingL2, nil
+	}
+	if err != nil {
+		return DepositAwaitingL2, fmt.Errorf("failed to get L2 transaction receipt: %w", err)
+	}
+	if receiptL2.BlockNumber == nil {
+		return DepositAwaitingL2, nil
+	}
+	if receiptL2.Status == types.ReceiptStatusSuccessful {
+		return DepositSucceeded, nil
+	}
+	if h.refundedOnL2 {
+		return DepositFailed, nil
+	}
+	return DepositClaimable, nil
+}
+
+// ClaimFailed claims the tokens of the deposit whose priority transaction failed on L2.
+// It returns an error if the deposit is not in the DepositClaimable status.
+func (h *DepositHandle) ClaimFailed(ctx context.Context) (*types.Transaction, error) {
+	if h.adapterL1 == nil {
+		return nil, errors.New("L1 adapter must be provided to claim failed deposit")
+	}
+	status, err := h.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if status != DepositClaimable {
+		return nil, fmt.Errorf("deposit is not claimable, status: %s", status)
+	}
+	l2Hash, err := h.L2Hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return h.adapterL1.ClaimFailedDeposit(&TransactOpts{Context: ensureContext(ctx)}, l2Hash)
+}
+
+// l2HashFromReceipt extracts the hash of the priority transaction from the L1 transaction receipt.
+func (h *DepositHandle) l2HashFromReceipt(ctx context.Context, receipt *types.Receipt) (common.Hash, error) {
+	mainContractAddress, err := (*h.clientL2).MainContractAddress(ctx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to get main contract address: %w", err)
+	}
+	l2Hash, _, err := utils.L2HashFromPriorityOp(receipt, mainContractAddress)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	h.mu.Lock()
+	h.l2Hash = &l2Hash
+	h.mu.Unlock()
+	return l2Hash, nil
+}
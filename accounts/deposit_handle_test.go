@@ -0,0 +1,193 @@
+package accounts
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/zksync-sdk/zksync2-go/contracts/zksync"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+	"testing"
+)
+
+var depositTestMainContract = common.HexToAddress("0x0000000000000000000000000000000000000aaa")
+
+// depositTestClientL1 is the stand-in for the L1 client which serves the receipt of the deposit transaction.
+type depositTestClientL1 struct {
+	receipt *types.Receipt
+}
+
+func (c *depositTestClientL1) TransactionReceipt(_ context.Context, _ common.Hash) (*types.Receipt, error) {
+	if c.receipt == nil {
+		return nil, ethereum.NotFound
+	}
+	return c.receipt, nil
+}
+
+func (c *depositTestClientL1) CodeAt(_ context.Context, _ common.Address, _ *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+// depositTestClientL2 is the stand-in for the L2 client which serves the receipt of the priority transaction.
+type depositTestClientL2 struct {
+	zkSyncClient
+	receipts map[common.Hash]*zkTypes.Receipt
+}
+
+func (c *depositTestClientL2) MainContractAddress(_ context.Context) (common.Address, error) {
+	return depositTestMainContract, nil
+}
+
+func (c *depositTestClientL2) TransactionReceipt(_ context.Context, hash common.Hash) (*zkTypes.Receipt, error) {
+	receipt, ok := c.receipts[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+// depositTestAdapterL1 is the stand-in for the L1 adapter which claims the failed deposit.
+type depositTestAdapterL1 struct {
+	AdapterL1
+	claimed []common.Hash
+}
+
+func (a *depositTestAdapterL1) ClaimFailedDeposit(_ *TransactOpts, depositHash common.Hash) (*types.Transaction, error) {
+	a.claimed = append(a.claimed, depositHash)
+	return types.NewTx(&types.DynamicFeeTx{}), nil
+}
+
+// newPriorityRequestLog returns the NewPriorityRequest event emitted by the main contract and the hash
+// of the priority transaction.
+func newPriorityRequestLog(t *testing.T) (*types.Log, common.Hash) {
+	mainContractAbi, err := zksync.IZkSyncMetaData.GetAbi()
+	assert.NoError(t, err, "GetAbi should not return error")
+	event := mainContractAbi.Events["NewPriorityRequest"]
+	canonicalTx := zksync.IMailboxL2CanonicalTransaction{
+		TxType:                 big.NewInt(255),
+		From:                   new(big.Int),
+		To:                     new(big.Int),
+		GasLimit:               new(big.Int),
+		GasPerPubdataByteLimit: new(big.Int),
+		MaxFeePerGas:           new(big.Int),
+		MaxPriorityFeePerGas:   new(big.Int),
+		Paymaster:              new(big.Int),
+		Nonce:                  new(big.Int),
+		Value:                  new(big.Int),
+		Reserved:               [4]*big.Int{new(big.Int), new(big.Int), new(big.Int), new(big.Int)},
+		FactoryDeps:            []*big.Int{},
+	}
+	encoded, err := abi.Arguments{event.Inputs[3]}.Pack(canonicalTx)
+	assert.NoError(t, err, "Pack should not return error")
+	l2Hash := crypto.Keccak256Hash(encoded)
+	data, err := event.Inputs.Pack(big.NewInt(1), l2Hash, uint64(0), canonicalTx, [][]byte{})
+	assert.NoError(t, err, "Pack should not return error")
+	return &types.Log{Address: depositTestMainContract, Topics: []common.Hash{event.ID}, Data: data}, l2Hash
+}
+
+func TestDepositHandleStatus(t *testing.T) {
+	priorityRequest, l2Hash := newPriorityRequestLog(t)
+	clientL1 := &depositTestClientL1{}
+	clientL2 := &depositTestClientL2{receipts: make(map[common.Hash]*zkTypes.Receipt)}
+	handle := NewDepositHandle(types.NewTx(&types.DynamicFeeTx{}), utils.EthAddress, clientL1, toClient(clientL2), nil)
+	// ETH is the base token of the chain
+	handle.refundedOnL2 = true
+
+	status, err := handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, DepositPendingL1, status, "Deposit should be pending on L1")
+
+	clientL1.receipt = &types.Receipt{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{priorityRequest}}
+	status, err = handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, DepositAwaitingL2, status, "Deposit should be awaiting L2")
+
+	hash, err := handle.L2Hash(context.Background())
+	assert.NoError(t, err, "L2Hash should not return error")
+	assert.Equal(t, l2Hash, hash, "L2 hash should be parsed from priority request")
+
+	clientL2.receipts[l2Hash] = &zkTypes.Receipt{Receipt: types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(1)}}
+	status, err = handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, DepositSucceeded, status, "Deposit should be succeeded")
+
+	clientL2.receipts[l2Hash].Status = types.ReceiptStatusFailed
+	status, err = handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, DepositFailed, status, "Failed ETH deposit should not be claimable")
+
+	clientL1.receipt.Status = types.ReceiptStatusFailed
+	status, err = handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, DepositFailed, status, "Deposit should be failed on L1")
+}
+
+func TestDepositHandleClaimFailed(t *testing.T) {
+	priorityRequest, l2Hash := newPriorityRequestLog(t)
+	clientL1 := &depositTestClientL1{receipt: &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		Logs:   []*types.Log{priorityRequest},
+	}}
+	clientL2 := &depositTestClientL2{receipts: map[common.Hash]*zkTypes.Receipt{
+		l2Hash: {Receipt: types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(1)}},
+	}}
+	adapterL1 := &depositTestAdapterL1{}
+	handle := NewDepositHandle(types.NewTx(&types.DynamicFeeTx{}), common.HexToAddress("0x0d"), clientL1, toClient(clientL2), adapterL1)
+
+	_, err := handle.ClaimFailed(context.Background())
+	assert.Error(t, err, "ClaimFailed should return error for successful deposit")
+
+	clientL2.receipts[l2Hash].Status = types.ReceiptStatusFailed
+	_, err = handle.ClaimFailed(context.Background())
+	assert.NoError(t, err, "ClaimFailed should not return error")
+	assert.Equal(t, []common.Hash{l2Hash}, adapterL1.claimed, "Failed deposit should be claimed by L2 hash")
+}
+
+func TestDepositHandleStatusRefundedOnL2(t *testing.T) {
+	priorityRequest, l2Hash := newPriorityRequestLog(t)
+	clientL1 := &depositTestClientL1{receipt: &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		Logs:   []*types.Log{priorityRequest},
+	}}
+	clientL2 := &depositTestClientL2{receipts: map[common.Hash]*zkTypes.Receipt{
+		l2Hash: {Receipt: types.Receipt{Status: types.ReceiptStatusFailed, BlockNumber: big.NewInt(1)}},
+	}}
+	adapterL1 := &depositTestAdapterL1{}
+	handle := NewDepositHandle(types.NewTx(&types.DynamicFeeTx{}), common.HexToAddress("0x0e"), clientL1, toClient(clientL2), adapterL1)
+	handle.refundedOnL2 = true
+
+	status, err := handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, DepositFailed, status, "Failed WETH deposit should not be claimable")
+
+	_, err = handle.ClaimFailed(context.Background())
+	assert.Error(t, err, "ClaimFailed should return error for deposit refunded on L2")
+	assert.Empty(t, adapterL1.claimed, "Deposit refunded on L2 should not be claimed")
+}
+
+func TestDepositHandleClaimFailedEthOnNonEthBasedChain(t *testing.T) {
+	priorityRequest, l2Hash := newPriorityRequestLog(t)
+	clientL1 := &depositTestClientL1{receipt: &types.Receipt{
+		Status: types.ReceiptStatusSuccessful,
+		Logs:   []*types.Log{priorityRequest},
+	}}
+	clientL2 := &depositTestClientL2{receipts: map[common.Hash]*zkTypes.Receipt{
+		l2Hash: {Receipt: types.Receipt{Status: types.ReceiptStatusFailed, BlockNumber: big.NewInt(1)}},
+	}}
+	adapterL1 := &depositTestAdapterL1{}
+	// ETH is deposited through the shared bridge, since it is not the base token of the chain
+	handle := NewDepositHandle(types.NewTx(&types.DynamicFeeTx{}), utils.EthAddress, clientL1, toClient(clientL2), adapterL1)
+
+	status, err := handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, DepositClaimable, status, "Failed ETH deposit through shared bridge should be claimable")
+
+	_, err = handle.ClaimFailed(context.Background())
+	assert.NoError(t, err, "ClaimFailed should not return error")
+	assert.Equal(t, []common.Hash{l2Hash}, adapterL1.claimed, "Failed deposit should be claimed by L2 hash")
+}
@@ -0,0 +1,94 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+)
+
+// TransactionEnvelope is a portable container of a populated EIP-712 transaction and its signature.
+// It enables the sign-then-broadcast workflow in which the transaction is signed on a machine
+// that never communicates with the network:
+//  1. The transaction is populated online using NewTransactionEnvelope and the envelope is
+//     serialized to JSON.
+//  2. The envelope is transferred to the offline machine and signed using TransactionEnvelope.Sign,
+//     which requires only a Signer.
+//  3. The signed envelope is transferred back and broadcast using TransactionEnvelope.Send.
+type TransactionEnvelope struct {
+	Transaction *zkTypes.Transaction712 `json:"transaction"`         // The populated transaction.
+	Signature   hexutil.Bytes           `json:"signature,omitempty"` // The signature of the transaction.
+}
+
+// NewTransactionEnvelope creates an unsigned envelope containing the transaction sent from the specified account.
+// Any unset transaction fields are prepared using the client in the same way as AdapterL2.PopulateTransaction does.
+func NewTransactionEnvelope(ctx context.Context, client *clients.Client, from common.Address, tx Transaction) (*TransactionEnvelope, error) {
+	if client == nil {
+		return nil, errors.New("client must be provided")
+	}
+	preparedTx, err := populateTransaction(ensureContext(ctx), *client, from, tx)
+	if err != nil {
+		return nil, err
+	}
+	return &TransactionEnvelope{Transaction: preparedTx}, nil
+}
+
+// Sign signs the transaction using the signer. It does not communicate with the network.
+// The signer must be associated with the sender of the transaction and with the same chain.
+func (e *TransactionEnvelope) Sign(signer Signer) error {
+	if e.Transaction == nil {
+		return errors.New("envelope does not contain transaction")
+	}
+	if e.Transaction.From == nil || *e.Transaction.From != signer.Address() {
+		return errors.New("signer is not the sender of the transaction")
+	}
+	if e.Transaction.ChainID == nil || e.Transaction.ChainID.Cmp(signer.Domain().ChainId) != 0 {
+		return errors.New("signer chain ID does not match transaction chain ID")
+	}
+	signature, err := signer.SignTypedData(signer.Domain(), e.Transaction)
+	if err != nil {
+		return fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	e.Signature = signature
+	return nil
+}
+
+// IsSigned returns true if the envelope contains the signature of the transaction.
+func (e *TransactionEnvelope) IsSigned() bool {
+	return len(e.Signature) > 0 || (e.Transaction != nil && e.Transaction.Meta != nil && len(e.Transaction.Meta.CustomSignature) > 0)
+}
+
+// RawTransaction returns the signed transaction that is ready to be broadcast to the network.
+func (e *TransactionEnvelope) RawTransaction() ([]byte, error) {
+	if e.Transaction == nil {
+		return nil, errors.New("envelope does not contain transaction")
+	}
+	if !e.IsSigned() {
+		return nil, errors.New("transaction is not signed")
+	}
+	return e.Transaction.RLPValues(e.Signature)
+}
+
+// Hash returns the hash of the signed transaction. The hash is computed locally and matches the hash
+// returned by the network once the transaction is broadcast.
+func (e *TransactionEnvelope) Hash() (common.Hash, error) {
+	if e.Transaction == nil {
+		return common.Hash{}, errors.New("envelope does not contain transaction")
+	}
+	return e.Transaction.Hash(e.Signature)
+}
+
+// Send broadcasts the signed transaction to the network.
+func (e *TransactionEnvelope) Send(ctx context.Context, client *clients.Client) (common.Hash, error) {
+	if client == nil {
+		return common.Hash{}, errors.New("client must be provided")
+	}
+	rawTx, err := e.RawTransaction()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return (*client).SendRawTransaction(ensureContext(ctx), rawTx)
+}
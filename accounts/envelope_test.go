@@ -0,0 +1,85 @@
+package accounts
+
+import (
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"math/big"
+	"testing"
+)
+
+func TestTransactionEnvelope(t *testing.T) {
+	signer, err := NewBaseSignerFromRawPrivateKey(common.Hex2Bytes("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110"), 270)
+	assert.NoError(t, err, "NewBaseSignerFromRawPrivateKey should not return error")
+
+	to := common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618")
+	tx := Transaction{
+		To:        &to,
+		Value:     big.NewInt(1_000),
+		Data:      hexutil.Bytes{},
+		Nonce:     big.NewInt(3),
+		GasTipCap: big.NewInt(0),
+		GasFeeCap: big.NewInt(250_000_000),
+		Gas:       1_000_000,
+		ChainID:   big.NewInt(270),
+		Meta: &zkTypes.Eip712Meta{
+			GasPerPubdata: (*hexutil.Big)(big.NewInt(50_000)),
+			FactoryDeps:   []hexutil.Bytes{make([]byte, 32)},
+			PaymasterParams: &zkTypes.PaymasterParams{
+				Paymaster:      common.HexToAddress("0x0a67078A35745947A37A552174aFe724D8180c25"),
+				PaymasterInput: common.Hex2Bytes("8c5a3445"),
+			},
+		},
+	}
+	unsigned := &TransactionEnvelope{Transaction: tx.ToTransaction712(signer.Address())}
+	assert.False(t, unsigned.IsSigned(), "Envelope should not be signed")
+
+	// transfer envelope to the offline machine
+	data, err := json.Marshal(unsigned)
+	assert.NoError(t, err, "Marshal should not return error")
+	var offline TransactionEnvelope
+	err = json.Unmarshal(data, &offline)
+	assert.NoError(t, err, "Unmarshal should not return error")
+	expectedDigest, err := unsigned.Transaction.SignedDigest()
+	assert.NoError(t, err, "SignedDigest should not return error")
+	offlineDigest, err := offline.Transaction.SignedDigest()
+	assert.NoError(t, err, "SignedDigest should not return error")
+	assert.Equal(t, expectedDigest, offlineDigest, "Transactions in envelopes should be the same")
+
+	err = offline.Sign(signer)
+	assert.NoError(t, err, "Sign should not return error")
+	assert.True(t, offline.IsSigned(), "Envelope should be signed")
+
+	// transfer envelope back to the online machine
+	data, err = json.Marshal(offline)
+	assert.NoError(t, err, "Marshal should not return error")
+	var online TransactionEnvelope
+	err = json.Unmarshal(data, &online)
+	assert.NoError(t, err, "Unmarshal should not return error")
+
+	rawTx, err := online.RawTransaction()
+	assert.NoError(t, err, "RawTransaction should not return error")
+	decoded, signature, err := zkTypes.DecodeTransaction712(rawTx)
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	sender, err := decoded.RecoverSigner(signature)
+	assert.NoError(t, err, "RecoverSigner should not return error")
+	assert.Equal(t, signer.Address(), sender, "Signer should be the sender of the transaction")
+
+	hash, err := online.Hash()
+	assert.NoError(t, err, "Hash should not return error")
+	expectedHash, err := decoded.Hash(nil)
+	assert.NoError(t, err, "Hash should not return error")
+	assert.Equal(t, expectedHash, hash, "Hashes should be the same")
+}
+
+func TestTransactionEnvelopeSignWrongSigner(t *testing.T) {
+	signer, err := NewRandomBaseSigner(270)
+	assert.NoError(t, err, "NewRandomBaseSigner should not return error")
+
+	from := common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049")
+	envelope := &TransactionEnvelope{Transaction: &zkTypes.Transaction712{From: &from, ChainID: big.NewInt(270)}}
+	err = envelope.Sign(signer)
+	assert.Error(t, err, "Sign should return error when signer is not the sender")
+}
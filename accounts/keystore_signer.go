@@ -0,0 +1,111 @@
+package accounts
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/zksync-sdk/zksync2-go/eip712"
+	"time"
+)
+
+// PassphraseFn returns the passphrase that decrypts the key of the account.
+type PassphraseFn func(account common.Address) (string, error)
+
+// KeystoreSigner is a Signer backed by the encrypted key file stored in the keystore.
+// The private key is never exposed and is decrypted only in one of the following ways:
+//   - for a single signing operation, if the signer is created with PassphraseFn,
+//   - for the period between Unlock and Lock, or until the unlock timeout expires.
+type KeystoreSigner struct {
+	keystore     *keystore.KeyStore
+	account      accounts.Account
+	domain       *eip712.Domain
+	passphraseFn PassphraseFn
+}
+
+// NewKeystoreSigner creates a new instance of KeystoreSigner for the account stored in the keystore.
+// If passphraseFn is not nil, it is used to decrypt the key for every signing operation,
+// and the key is removed from the memory as soon as the operation completes. Otherwise,
+// the account must be unlocked using Unlock before signing.
+func NewKeystoreSigner(ks *keystore.KeyStore, address common.Address, chainId int64, passphraseFn PassphraseFn) (*KeystoreSigner, error) {
+	account, err := ks.Find(accounts.Account{Address: address})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account %s in keystore: %w", address, err)
+	}
+	return &KeystoreSigner{
+		keystore:     ks,
+		account:      account,
+		domain:       eip712.ZkSyncEraEIP712Domain(chainId),
+		passphraseFn: passphraseFn,
+	}, nil
+}
+
+// NewKeystoreSignerFromDir creates a new instance of KeystoreSigner for the account whose encrypted key file
+// is stored in the keystore directory.
+func NewKeystoreSignerFromDir(keydir string, address common.Address, chainId int64, passphraseFn PassphraseFn) (*KeystoreSigner, error) {
+	return NewKeystoreSigner(keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP),
+		address, chainId, passphraseFn)
+}
+
+// Unlock decrypts the key of the account and keeps it in the memory for the duration of the timeout,
+// after which the account is locked automatically. A timeout of 0 unlocks the account until Lock is called.
+func (s *KeystoreSigner) Unlock(passphrase string, timeout time.Duration) error {
+	if err := s.keystore.TimedUnlock(s.account, passphrase, timeout); err != nil {
+		return fmt.Errorf("failed to unlock account: %w", err)
+	}
+	return nil
+}
+
+// Lock removes the decrypted key of the account from the memory.
+func (s *KeystoreSigner) Lock() error {
+	return s.keystore.Lock(s.account.Address)
+}
+
+func (s *KeystoreSigner) Address() common.Address {
+	return s.account.Address
+}
+
+func (s *KeystoreSigner) Domain() *eip712.Domain {
+	return s.domain
+}
+
+// PrivateKey always returns nil, since the keystore does not reveal the private key.
+func (s *KeystoreSigner) PrivateKey() *ecdsa.PrivateKey {
+	return nil
+}
+
+func (s *KeystoreSigner) SignHash(msg []byte) ([]byte, error) {
+	var (
+		sig []byte
+		err error
+	)
+	if s.passphraseFn != nil {
+		passphrase, errPass := s.passphraseFn(s.account.Address)
+		if errPass != nil {
+			return nil, fmt.Errorf("failed to get passphrase: %w", errPass)
+		}
+		sig, err = s.keystore.SignHashWithPassphrase(s.account, passphrase, msg)
+	} else {
+		sig, err = s.keystore.SignHash(s.account, msg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign hash: %w", err)
+	}
+	return sig, nil
+}
+
+func (s *KeystoreSigner) SignTypedData(domain *eip712.Domain, data eip712.TypedData) ([]byte, error) {
+	hash, err := eip712.HashTypedData(domain, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hash of typed data: %w", err)
+	}
+	sig, err := s.SignHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign hash of typed data: %w", err)
+	}
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+	return sig, nil
+}
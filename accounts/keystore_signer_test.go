@@ -0,0 +1,110 @@
+package accounts
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func newTestKeystore(t *testing.T, passphrase string) (*keystore.KeyStore, *BaseSigner) {
+	signer, err := NewBaseSignerFromRawPrivateKey(common.Hex2Bytes("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110"), 270)
+	assert.NoError(t, err, "NewBaseSignerFromRawPrivateKey should not return error")
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	_, err = ks.ImportECDSA(signer.PrivateKey(), passphrase)
+	assert.NoError(t, err, "ImportECDSA should not return error")
+	return ks, signer
+}
+
+func TestKeystoreSignerUnlock(t *testing.T) {
+	ks, baseSigner := newTestKeystore(t, "password")
+	signer, err := NewKeystoreSigner(ks, baseSigner.Address(), 270, nil)
+	assert.NoError(t, err, "NewKeystoreSigner should not return error")
+	assert.Equal(t, baseSigner.Address(), signer.Address(), "Addresses should be the same")
+	assert.Nil(t, signer.PrivateKey(), "Private key should not be revealed")
+
+	hash := crypto.Keccak256([]byte("Hello, zkSync!"))
+	_, err = signer.SignHash(hash)
+	assert.Error(t, err, "SignHash should return error when account is locked")
+
+	err = signer.Unlock("wrong", 0)
+	assert.Error(t, err, "Unlock should return error for wrong passphrase")
+	err = signer.Unlock("password", 0)
+	assert.NoError(t, err, "Unlock should not return error")
+
+	signature, err := signer.SignHash(hash)
+	assert.NoError(t, err, "SignHash should not return error")
+	expected, err := baseSigner.SignHash(hash)
+	assert.NoError(t, err, "SignHash should not return error")
+	assert.Equal(t, expected, signature, "Signatures should be the same")
+
+	tx := newTestSmartAccountTransaction()
+	from := baseSigner.Address()
+	tx.From = &from
+	typedSignature, err := signer.SignTypedData(signer.Domain(), tx)
+	assert.NoError(t, err, "SignTypedData should not return error")
+	expectedTypedSignature, err := baseSigner.SignTypedData(baseSigner.Domain(), tx)
+	assert.NoError(t, err, "SignTypedData should not return error")
+	assert.Equal(t, expectedTypedSignature, typedSignature, "Typed data signatures should be the same")
+
+	err = signer.Lock()
+	assert.NoError(t, err, "Lock should not return error")
+	_, err = signer.SignHash(hash)
+	assert.Error(t, err, "SignHash should return error when account is locked")
+}
+
+func TestKeystoreSignerUnlockTimeout(t *testing.T) {
+	ks, baseSigner := newTestKeystore(t, "password")
+	signer, err := NewKeystoreSigner(ks, baseSigner.Address(), 270, nil)
+	assert.NoError(t, err, "NewKeystoreSigner should not return error")
+
+	err = signer.Unlock("password", 50*time.Millisecond)
+	assert.NoError(t, err, "Unlock should not return error")
+	_, err = signer.SignHash(crypto.Keccak256([]byte("Hello, zkSync!")))
+	assert.NoError(t, err, "SignHash should not return error")
+
+	time.Sleep(200 * time.Millisecond)
+	_, err = signer.SignHash(crypto.Keccak256([]byte("Hello, zkSync!")))
+	assert.Error(t, err, "SignHash should return error when unlock timeout expires")
+}
+
+func TestKeystoreSignerPassphraseFn(t *testing.T) {
+	ks, baseSigner := newTestKeystore(t, "password")
+	signer, err := NewKeystoreSigner(ks, baseSigner.Address(), 270, func(account common.Address) (string, error) {
+		return "password", nil
+	})
+	assert.NoError(t, err, "NewKeystoreSigner should not return error")
+
+	// sign L1 transaction the same way as WalletL1 does
+	auth, err := newTransactorWithSigner(toSigner(signer), big.NewInt(9))
+	assert.NoError(t, err, "newTransactorWithSigner should not return error")
+	to := common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618")
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(9),
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1_000_000_000),
+		Gas:       21_000,
+		To:        &to,
+		Value:     big.NewInt(1_000),
+	})
+	signedTx, err := auth.Signer(signer.Address(), tx)
+	assert.NoError(t, err, "Signer should not return error")
+	sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(9)), signedTx)
+	assert.NoError(t, err, "Sender should not return error")
+	assert.Equal(t, baseSigner.Address(), sender, "Sender should be the keystore account")
+}
+
+func TestNewKeystoreSignerUnknownAccount(t *testing.T) {
+	ks, _ := newTestKeystore(t, "password")
+	_, err := NewKeystoreSigner(ks, common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618"), 270, nil)
+	assert.Error(t, err, "NewKeystoreSigner should return error for unknown account")
+}
+
+func toSigner(signer Signer) *Signer {
+	return &signer
+}
@@ -0,0 +1,186 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1messenger"
+	"github.com/zksync-sdk/zksync2-go/contracts/zksync"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+)
+
+// L2MessageProof contains the message sent from L2 network to L1 network via L1Messenger.sendToL1,
+// along with the proof of its inclusion in the batch. It provides the arguments of the
+// IZkSync.proveL2MessageInclusion method, which can also be used by custom L1 contracts that verify
+// the messages.
+type L2MessageProof struct {
+	L2BatchNumber     *big.Int       // The number of the batch in which the message was sent.
+	L2MessageIndex    *big.Int       // The position of the L2 -> L1 log in the batch.
+	L2TxNumberInBatch uint16         // The position of the transaction that sent the message in the batch.
+	Sender            common.Address // The account on L2 network that sent the message.
+	Message           []byte         // The content of the message.
+	Proof             [][32]byte     // The Merkle proof of the inclusion of the message in the batch.
+	Root              common.Hash    // The root of the Merkle tree of the L2 -> L1 logs of the batch.
+}
+
+// L2Message returns the message in the representation expected by IZkSync.proveL2MessageInclusion.
+func (p *L2MessageProof) L2Message() zksync.L2Message {
+	return zksync.L2Message{
+		TxNumberInBatch: p.L2TxNumberInBatch,
+		Sender:          p.Sender,
+		Data:            p.Message,
+	}
+}
+
+// ProveInclusionCalldata returns the calldata of the IZkSync.proveL2MessageInclusion call for the message.
+func (p *L2MessageProof) ProveInclusionCalldata() ([]byte, error) {
+	zkSyncAbi, err := zksync.IZkSyncMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IZkSync ABI: %w", err)
+	}
+	return zkSyncAbi.Pack("proveL2MessageInclusion", p.L2BatchNumber, p.L2MessageIndex, p.L2Message(), p.Proof)
+}
+
+// l2MessageProof returns the proof of the message sent by the transaction via L1Messenger.sendToL1.
+// The index is the position of the message among the messages sent by the transaction.
+func l2MessageProof(ctx context.Context, client *clients.Client, txHash common.Hash, index int) (*L2MessageProof, error) {
+	log, l1BatchTxId, err := l1MessageSentLog(ctx, client, txHash, index)
+	if err != nil {
+		return nil, err
+	}
+	if l1BatchTxId == nil {
+		return nil, errors.New("empty l1BatchTxIndex")
+	}
+	if log.L1BatchNumber == nil {
+		return nil, errors.New("transaction is not included in a batch")
+	}
+	if len(log.Topics) < 2 {
+		return nil, errors.New("not enough Topics count")
+	}
+	l2ToL1LogIndex, _, err := l1MessengerL2ToL1Log(ctx, client, txHash, index)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := (*client).LogProof(ctx, txHash, l2ToL1LogIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L2ToL1LogProof: %w", err)
+	}
+	if proof == nil {
+		return nil, errors.New("log proof not found")
+	}
+
+	l1MessengerAbi, err := l1messenger.IL1MessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load l1MessengerAbi: %w", err)
+	}
+	data, err := l1MessengerAbi.Unpack("L1MessageSent", log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Unpack log data: %w", err)
+	}
+	message, ok := data[0].([]byte)
+	if !ok {
+		return nil, errors.New("failed to parse message from log data")
+	}
+	proof32 := make([][32]byte, len(proof.Proof))
+	for i, pr := range proof.Proof {
+		proof32[i] = pr
+	}
+	return &L2MessageProof{
+		L2BatchNumber:     log.L1BatchNumber.ToInt(),
+		L2MessageIndex:    big.NewInt(int64(proof.Id)),
+		L2TxNumberInBatch: uint16(l1BatchTxId.Uint64()),
+		Sender:            common.BytesToAddress(log.Topics[1].Bytes()[12:]),
+		Message:           message,
+		Proof:             proof32,
+		Root:              proof.Root,
+	}, nil
+}
+
+// verifyL2MessageProof checks that the L2 -> L1 log sent by the L1Messenger commits to the message, and that
+// the Merkle proof of the log leads to the root provided by the proof.
+func verifyL2MessageProof(proof *L2MessageProof, l2ToL1Log *zkTypes.L2ToL1Log) error {
+	logCopy := *l2ToL1Log
+	l2ToL1Log = &logCopy
+	if l2ToL1Log.Sender != utils.L1MessengerAddress || !l2ToL1Log.IsService {
+		return errors.New("L2ToL1 log is not sent by L1Messenger")
+	}
+	if common.HexToHash(l2ToL1Log.Key) != common.BytesToHash(proof.Sender.Bytes()) {
+		return errors.New("L2ToL1 log key does not match the message sender")
+	}
+	if common.HexToHash(l2ToL1Log.Value) != crypto.Keccak256Hash(proof.Message) {
+		return errors.New("L2ToL1 log value does not match the message hash")
+	}
+	txIndexInL1Batch := hexutil.Uint(proof.L2TxNumberInBatch)
+	if l2ToL1Log.TxIndexInL1Batch != nil && *l2ToL1Log.TxIndexInL1Batch != txIndexInL1Batch {
+		return errors.New("L2ToL1 log transaction index does not match the message")
+	}
+	l2ToL1Log.TxIndexInL1Batch = &txIndexInL1Batch
+
+	proofHashes := make([]common.Hash, len(proof.Proof))
+	for i, pr := range proof.Proof {
+		proofHashes[i] = pr
+	}
+	return utils.VerifyL2ToL1LogProof(l2ToL1Log, &zkTypes.MessageProof{
+		Id:    int(proof.L2MessageIndex.Int64()),
+		Proof: proofHashes,
+		Root:  proof.Root,
+	})
+}
+
+// l1MessageSentLog returns the L1MessageSent event emitted by the L1Messenger for the message sent by
+// the transaction, along with the index of the transaction in the batch.
+func l1MessageSentLog(ctx context.Context, client *clients.Client, txHash common.Hash, index int) (*zkTypes.Log, *big.Int, error) {
+	receipt, err := (*client).TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get TransactionReceipt: %w", err)
+	}
+	if receipt == nil {
+		return nil, nil, errors.New("transaction receipt not found")
+	}
+	fLogs := make([]*zkTypes.Log, 0)
+	for _, l := range receipt.Logs {
+		if l.Address == utils.L1MessengerAddress && len(l.Topics) > 0 &&
+			bytes.Equal(l.Topics[0].Bytes(), crypto.Keccak256([]byte("L1MessageSent(address,bytes32,bytes)"))) {
+			fLogs = append(fLogs, l)
+		}
+	}
+	if len(fLogs) < index+1 {
+		return nil, nil, errors.New("L1MessageSent log not found")
+	}
+	return fLogs[index], receipt.L1BatchTxIndex.ToInt(), nil
+}
+
+// l1MessengerL2ToL1Log returns the L2 -> L1 log sent by the L1Messenger for the message sent by the transaction,
+// along with the position of the log among all L2 -> L1 logs of the transaction.
+func l1MessengerL2ToL1Log(ctx context.Context, client *clients.Client, txHash common.Hash, index int) (int, *zkTypes.L2ToL1Log, error) {
+	receipt, err := (*client).TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get TransactionReceipt: %w", err)
+	}
+	if receipt == nil {
+		return 0, nil, errors.New("transaction receipt not found")
+	}
+	fLogs := make([]struct {
+		i int
+		l *zkTypes.L2ToL1Log
+	}, 0)
+	for i, l := range receipt.L2ToL1Logs {
+		if l.Sender == utils.L1MessengerAddress {
+			fLogs = append(fLogs, struct {
+				i int
+				l *zkTypes.L2ToL1Log
+			}{i, l})
+		}
+	}
+	if len(fLogs) < index+1 {
+		return 0, nil, errors.New("L2ToL1 log not found")
+	}
+	return fLogs[index].i, fLogs[index].l, nil
+}
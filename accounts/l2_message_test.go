@@ -0,0 +1,159 @@
+package accounts
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1messenger"
+	"github.com/zksync-sdk/zksync2-go/contracts/zksync"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"github.com/zksync-sdk/zksync2-go/zksynctest"
+	"math/big"
+	"testing"
+)
+
+// messageTestClient is the stand-in for the client which serves the receipt of the transaction
+// that sent two messages to L1 network, and the proofs of their L2 -> L1 logs.
+type messageTestClient struct {
+	zkSyncClient
+	receipt   *zkTypes.Receipt
+	logIndex  int
+	logProofs map[int]*zkTypes.MessageProof
+}
+
+func (c *messageTestClient) TransactionReceipt(_ context.Context, _ common.Hash) (*zkTypes.Receipt, error) {
+	return c.receipt, nil
+}
+
+func (c *messageTestClient) LogProof(_ context.Context, _ common.Hash, logIndex int) (*zkTypes.MessageProof, error) {
+	c.logIndex = logIndex
+	return c.logProofs[logIndex], nil
+}
+
+func newMessageSentLog(t *testing.T, sender common.Address, message []byte) *zkTypes.Log {
+	l1MessengerAbi, err := l1messenger.IL1MessengerMetaData.GetAbi()
+	assert.NoError(t, err, "GetAbi should not return error")
+	data, err := l1MessengerAbi.Events["L1MessageSent"].Inputs.NonIndexed().Pack(message)
+	assert.NoError(t, err, "Pack should not return error")
+	return &zkTypes.Log{
+		Log: types.Log{
+			Address: utils.L1MessengerAddress,
+			Topics: []common.Hash{
+				crypto.Keccak256Hash([]byte("L1MessageSent(address,bytes32,bytes)")),
+				common.BytesToHash(sender.Bytes()),
+				crypto.Keccak256Hash(message),
+			},
+			Data: data,
+		},
+		L1BatchNumber: (*hexutil.Big)(big.NewInt(42)),
+	}
+}
+
+func TestL2MessageProof(t *testing.T) {
+	sender := common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049")
+	message := []byte("vote: yes")
+	client := &messageTestClient{
+		receipt: &zkTypes.Receipt{
+			L1BatchTxIndex: (*hexutil.Big)(big.NewInt(3)),
+			Logs: []*zkTypes.Log{
+				newMessageSentLog(t, sender, []byte("vote: no")),
+				newMessageSentLog(t, sender, message),
+			},
+			L2ToL1Logs: []*zkTypes.L2ToL1Log{
+				{Sender: utils.L1MessengerAddress},
+				{Sender: utils.BootloaderFormalAddress},
+				{Sender: utils.L1MessengerAddress},
+			},
+		},
+		logProofs: map[int]*zkTypes.MessageProof{
+			2: {Id: 7, Proof: []common.Hash{common.HexToHash("0x01")}, Root: common.HexToHash("0x02")},
+		},
+	}
+
+	proof, err := l2MessageProof(context.Background(), toClient(client), common.Hash{}, 1)
+	assert.NoError(t, err, "l2MessageProof should not return error")
+	assert.Equal(t, 2, client.logIndex, "Proof should be requested for the L2 -> L1 log of the message")
+	assert.Equal(t, &L2MessageProof{
+		L2BatchNumber:     big.NewInt(42),
+		L2MessageIndex:    big.NewInt(7),
+		L2TxNumberInBatch: 3,
+		Sender:            sender,
+		Message:           message,
+		Proof:             [][32]byte{common.HexToHash("0x01")},
+		Root:              common.HexToHash("0x02"),
+	}, proof, "Proofs should be the same")
+
+	calldata, err := proof.ProveInclusionCalldata()
+	assert.NoError(t, err, "ProveInclusionCalldata should not return error")
+	zkSyncAbi, err := zksync.IZkSyncMetaData.GetAbi()
+	assert.NoError(t, err, "GetAbi should not return error")
+	method := zkSyncAbi.Methods["proveL2MessageInclusion"]
+	assert.Equal(t, method.ID, calldata[:4], "Calldata should call proveL2MessageInclusion")
+	args, err := method.Inputs.Unpack(calldata[4:])
+	assert.NoError(t, err, "Unpack should not return error")
+	l2Message := *abi.ConvertType(args[2], new(zksync.L2Message)).(*zksync.L2Message)
+	assert.Equal(t, proof.L2Message(), l2Message, "Messages should be the same")
+
+	_, err = l2MessageProof(context.Background(), toClient(client), common.Hash{}, 2)
+	assert.Error(t, err, "l2MessageProof should return error for missing message")
+}
+
+func TestVerifyL2MessageProof(t *testing.T) {
+	message := []byte("vote: yes")
+	txIndex := hexutil.Uint(3)
+	l2ToL1Log := &zkTypes.L2ToL1Log{
+		TxIndexInL1Batch: &txIndex,
+		IsService:        true,
+		Sender:           utils.L1MessengerAddress,
+		Key:              common.HexToHash("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049").Hex(),
+		Value:            crypto.Keccak256Hash(message).Hex(),
+	}
+	leaf, err := utils.L2ToL1LogLeaf(l2ToL1Log)
+	assert.NoError(t, err, "L2ToL1LogLeaf should not return error")
+	sibling := common.HexToHash("0x05")
+	proof := &L2MessageProof{
+		L2BatchNumber:     big.NewInt(42),
+		L2MessageIndex:    big.NewInt(0),
+		L2TxNumberInBatch: 3,
+		Sender:            common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049"),
+		Message:           message,
+		Proof:             [][32]byte{sibling},
+		Root:              crypto.Keccak256Hash(leaf.Bytes(), sibling.Bytes()),
+	}
+	assert.NoError(t, verifyL2MessageProof(proof, l2ToL1Log), "verifyL2MessageProof should not return error")
+
+	l2ToL1Log.TxIndexInL1Batch = nil
+	assert.NoError(t, verifyL2MessageProof(proof, l2ToL1Log), "verifyL2MessageProof should use transaction index of the message")
+	assert.Nil(t, l2ToL1Log.TxIndexInL1Batch, "verifyL2MessageProof should not modify the log")
+
+	proof.Message = []byte("vote: no")
+	assert.Error(t, verifyL2MessageProof(proof, l2ToL1Log), "verifyL2MessageProof should return error for other message")
+	proof.Message = message
+	proof.L2MessageIndex = big.NewInt(1)
+	assert.Error(t, verifyL2MessageProof(proof, l2ToL1Log), "verifyL2MessageProof should return error for wrong position")
+}
+
+func TestWalletL2SendMessageToL1ReusedOpts(t *testing.T) {
+	node := zksynctest.NewNode(zksynctest.Config{})
+	defer node.Close()
+	client := node.Client()
+	wallet, err := NewWalletL2(common.Hex2Bytes("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110"), &client)
+	assert.NoError(t, err, "NewWalletL2 should not return error")
+	wallet.SetNonceManager(NewSequentialNonceManager(&client, wallet.Address()))
+	node.SetBalance(wallet.Address(), big.NewInt(1_000_000_000_000_000_000))
+
+	opts := &TransactOpts{Context: context.Background()}
+	_, err = wallet.SendMessageToL1(opts, []byte("first"))
+	assert.NoError(t, err, "SendMessageToL1 should not return error")
+	second, err := wallet.SendMessageToL1(opts, []byte("second"))
+	assert.NoError(t, err, "SendMessageToL1 should not return error for reused options")
+	assert.Nil(t, opts.Nonce, "Allocated nonce should not be written to options")
+	secondTx, _, err := client.TransactionByHash(context.Background(), second)
+	assert.NoError(t, err, "TransactionByHash should not return error")
+	assert.Equal(t, uint64(1), uint64(secondTx.Nonce), "Nonces should be the same")
+}
@@ -0,0 +1,394 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	"github.com/zksync-sdk/zksync2-go/eip712"
+	"math/big"
+	"sort"
+	"sync"
+)
+
+// MultiChainWallet wraps the operations of an account on several L2 chains that settle on the same L1 network.
+// It holds one signer, one L1 client and a Wallet for each L2 chain, keyed by the chain ID. The signer of each
+// chain signs EIP-712 transactions within the domain of that chain, regardless of the domain of the provided signer.
+type MultiChainWallet struct {
+	signer   *Signer
+	clientL1 *ethclient.Client
+
+	mu        sync.RWMutex
+	chains    map[int64]*Wallet
+	transfers []*CrossChainTransfer
+}
+
+// MultiChainBalance represents the balance of the token held by the account across the L2 chains.
+type MultiChainBalance struct {
+	Total  *big.Int           // The sum of the balances on all chains.
+	Chains map[int64]*big.Int // The balance on each chain, keyed by the chain ID.
+}
+
+// NewMultiChainWallet creates an instance of MultiChainWallet associated with the account provided by the signer,
+// and connects it to the provided L2 chains. The clientL1 parameter is optional; if not provided, the operations
+// that require communication with the L1 network, including cross-chain transfers, can not be performed.
+// Additional L2 chains can be connected using MultiChainWallet.AddChain.
+func NewMultiChainWallet(signer *Signer, clientL1 *ethclient.Client, clientsL2 ...*clients.Client) (*MultiChainWallet, error) {
+	if signer == nil {
+		return nil, errors.New("signer must be provided")
+	}
+	w := &MultiChainWallet{
+		signer:   signer,
+		clientL1: clientL1,
+		chains:   make(map[int64]*Wallet),
+	}
+	for _, clientL2 := range clientsL2 {
+		if _, err := w.AddChain(context.Background(), clientL2); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// AddChain connects the wallet to the L2 chain served by the client and returns the Wallet of the chain.
+// If the wallet is already connected to the chain, the previous connection is replaced.
+func (w *MultiChainWallet) AddChain(ctx context.Context, clientL2 *clients.Client) (*Wallet, error) {
+	if clientL2 == nil {
+		return nil, errors.New("clientL2 must be provided")
+	}
+	chainID, err := (*clientL2).ChainID(ensureContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	signer := newChainSigner(*w.signer, chainID)
+	wallet, err := NewWalletFromSigner(&signer, clientL2, w.clientL1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to chain %d: %w", chainID, err)
+	}
+
+	w.mu.Lock()
+	w.chains[chainID.Int64()] = wallet
+	w.mu.Unlock()
+	return wallet, nil
+}
+
+// Address returns the address of the associated account, which is the same on all chains.
+func (w *MultiChainWallet) Address() common.Address {
+	return (*w.signer).Address()
+}
+
+// ChainIDs returns the IDs of the connected L2 chains in ascending order.
+func (w *MultiChainWallet) ChainIDs() []int64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	ids := make([]int64, 0, len(w.chains))
+	for id := range w.chains {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Wallet returns the Wallet of the L2 chain with the specified ID.
+func (w *MultiChainWallet) Wallet(chainID int64) (*Wallet, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	wallet, ok := w.chains[chainID]
+	if !ok {
+		return nil, fmt.Errorf("chain %d is not connected", chainID)
+	}
+	return wallet, nil
+}
+
+// L2 returns the view of the account on the L2 chain with the specified ID.
+func (w *MultiChainWallet) L2(chainID int64) (AdapterL2, error) {
+	wallet, err := w.Wallet(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return wallet.AdapterL2, nil
+}
+
+// Balances returns the balance of the token on each connected L2 chain, along with their sum. The token is
+// specified by its L1 address, which is resolved to the L2 address of the token on each chain;
+// utils.EthAddress is used for ETH. The block number can be nil, in which case the balances are taken
+// from the latest known blocks.
+func (w *MultiChainWallet) Balances(ctx context.Context, token common.Address, at *big.Int) (*MultiChainBalance, error) {
+	ctx = ensureContext(ctx)
+	balances := &MultiChainBalance{
+		Total:  big.NewInt(0),
+		Chains: make(map[int64]*big.Int),
+	}
+	for _, chainID := range w.ChainIDs() {
+		wallet, err := w.Wallet(chainID)
+		if err != nil {
+			return nil, err
+		}
+		l2Token, err := (*wallet.clientL2).L2TokenAddress(ctx, token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get L2 token address on chain %d: %w", chainID, err)
+		}
+		balance, err := wallet.Balance(ctx, l2Token, at)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get balance on chain %d: %w", chainID, err)
+		}
+		balances.Chains[chainID] = balance
+		balances.Total.Add(balances.Total, balance)
+	}
+	return balances, nil
+}
+
+// TransferCrossChain moves the token from the associated account on one L2 chain to the target account
+// on another L2 chain, by withdrawing the token to L1 network and depositing it to the target chain.
+// It initiates the withdrawal and returns the transfer, which is completed using CrossChainTransfer.Complete
+// once the withdrawal can be finalized. The transfer is tracked by the wallet and listed by
+// MultiChainWallet.Transfers.
+func (w *MultiChainWallet) TransferCrossChain(auth *TransactOpts, tx CrossChainTransferTransaction) (*CrossChainTransfer, error) {
+	if w.clientL1 == nil {
+		return nil, errors.New("clientL1 must be provided to transfer tokens across chains")
+	}
+	if tx.FromChainID == tx.ToChainID {
+		return nil, errors.New("source and target chains must be different")
+	}
+	opts := ensureTransactOpts(auth)
+	from, err := w.Wallet(tx.FromChainID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := w.Wallet(tx.ToChainID)
+	if err != nil {
+		return nil, err
+	}
+	if tx.To == (common.Address{}) {
+		tx.To = w.Address()
+	}
+
+	l2Token, err := (*from.clientL2).L2TokenAddress(opts.Context, tx.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L2 token address on chain %d: %w", tx.FromChainID, err)
+	}
+	withdrawal, err := from.Withdraw(opts, WithdrawalTransaction{
+		To:     w.Address(),
+		Token:  l2Token,
+		Amount: tx.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := &CrossChainTransfer{
+		CrossChainTransferTransaction: tx,
+		Withdrawal:                    withdrawal,
+		clientL1:                      w.clientL1,
+		target:                        to,
+	}
+	w.mu.Lock()
+	w.transfers = append(w.transfers, transfer)
+	w.mu.Unlock()
+	return transfer, nil
+}
+
+// Transfers returns the cross-chain transfers initiated by the wallet, in the order they were initiated.
+func (w *MultiChainWallet) Transfers() []*CrossChainTransfer {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return append([]*CrossChainTransfer(nil), w.transfers...)
+}
+
+// chainSigner wraps the Signer to sign EIP-712 transactions within the domain of a specific chain.
+type chainSigner struct {
+	Signer
+	domain *eip712.Domain
+}
+
+// chainTxSigner is the chainSigner whose underlying Signer implements TxSigner.
+type chainTxSigner struct {
+	*chainSigner
+	TxSigner
+}
+
+// newChainSigner returns the signer whose domain is the domain of the provided signer with the chain ID replaced.
+// The returned signer implements TxSigner if the provided signer does.
+func newChainSigner(signer Signer, chainID *big.Int) Signer {
+	domain := eip712.ZkSyncEraEIP712Domain(chainID.Int64())
+	if d := signer.Domain(); d != nil {
+		copied := *d
+		copied.ChainId = new(big.Int).Set(chainID)
+		domain = &copied
+	}
+	s := &chainSigner{Signer: signer, domain: domain}
+	if txSigner, ok := signer.(TxSigner); ok {
+		return &chainTxSigner{chainSigner: s, TxSigner: txSigner}
+	}
+	return s
+}
+
+func (s *chainSigner) Domain() *eip712.Domain {
+	return s.domain
+}
+
+// CrossChainTransferStatus represents the stage of the cross-chain transfer.
+type CrossChainTransferStatus uint8
+
+const (
+	// CrossChainWithdrawing means that the withdrawal from the source chain can not be finalized yet.
+	CrossChainWithdrawing CrossChainTransferStatus = iota
+	// CrossChainFinalizable means that the withdrawal from the source chain can be finalized.
+	CrossChainFinalizable
+	// CrossChainWithdrawn means that the withdrawal is finalized on L1 network, but the deposit
+	// to the target chain is not yet initiated.
+	CrossChainWithdrawn
+	// CrossChainDepositing means that the deposit to the target chain is initiated, but not yet executed.
+	CrossChainDepositing
+	// CrossChainSucceeded means that the deposit is successfully executed on the target chain.
+	CrossChainSucceeded
+	// CrossChainClaimable means that the deposit failed on the target chain and the tokens can be claimed
+	// back on L1 network using the DepositHandle.ClaimFailed of CrossChainTransfer.Deposit.
+	CrossChainClaimable
+	// CrossChainFailed means that either the withdrawal or the deposit failed.
+	CrossChainFailed
+)
+
+func (s CrossChainTransferStatus) String() string {
+	switch s {
+	case CrossChainWithdrawing:
+		return "withdrawing"
+	case CrossChainFinalizable:
+		return "finalizable"
+	case CrossChainWithdrawn:
+		return "withdrawn"
+	case CrossChainDepositing:
+		return "depositing"
+	case CrossChainSucceeded:
+		return "succeeded"
+	case CrossChainClaimable:
+		return "claimable"
+	case CrossChainFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// CrossChainTransfer tracks the transfer of the token from one L2 chain to another, which consists of
+// the withdrawal from the source chain and the deposit to the target chain.
+type CrossChainTransfer struct {
+	CrossChainTransferTransaction
+	Withdrawal *WithdrawalHandle // The withdrawal from the source chain.
+
+	clientL1 *ethclient.Client
+	target   *Wallet
+
+	mu         sync.Mutex
+	completing bool
+	deposit    *DepositHandle
+}
+
+// Deposit returns the deposit to the target chain, or nil if the deposit is not yet initiated.
+func (t *CrossChainTransfer) Deposit() *DepositHandle {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.deposit
+}
+
+// Status returns the current stage of the transfer.
+func (t *CrossChainTransfer) Status(ctx context.Context) (CrossChainTransferStatus, error) {
+	if deposit := t.Deposit(); deposit != nil {
+		status, err := deposit.Status(ctx)
+		if err != nil {
+			return CrossChainDepositing, err
+		}
+		switch status {
+		case DepositSucceeded:
+			return CrossChainSucceeded, nil
+		case DepositClaimable:
+			return CrossChainClaimable, nil
+		case DepositFailed:
+			return CrossChainFailed, nil
+		default:
+			return CrossChainDepositing, nil
+		}
+	}
+	status, err := t.Withdrawal.Status(ctx)
+	if err != nil {
+		return CrossChainWithdrawing, err
+	}
+	switch status {
+	case WithdrawalFinalized:
+		return CrossChainWithdrawn, nil
+	case WithdrawalFinalizable:
+		return CrossChainFinalizable, nil
+	case WithdrawalFailed:
+		return CrossChainFailed, nil
+	default:
+		return CrossChainWithdrawing, nil
+	}
+}
+
+// Complete waits until the withdrawal can be finalized, finalizes it on L1 network unless it is already
+// finalized, and deposits the withdrawn tokens to the target chain. The tokens required by the deposit,
+// including the base token of the target chain, are approved if needed. If the deposit is already
+// initiated, it is returned without sending any transaction. The auth is used for the finalization and
+// the deposit, where the Nonce, if set, applies only to the finalization transaction.
+// It returns an error if the transfer is being completed by another call.
+func (t *CrossChainTransfer) Complete(auth *TransactOpts) (*DepositHandle, error) {
+	t.mu.Lock()
+	if t.deposit != nil || t.completing {
+		deposit := t.deposit
+		t.mu.Unlock()
+		if deposit == nil {
+			return nil, errors.New("transfer is already being completed")
+		}
+		return deposit, nil
+	}
+	t.completing = true
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		t.completing = false
+		t.mu.Unlock()
+	}()
+	opts := *ensureTransactOpts(auth)
+
+	finalizeTx, err := t.Withdrawal.finalize(&opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize withdrawal: %w", err)
+	}
+	if finalizeTx != nil {
+		if err = waitMinedL1(opts.Context, t.clientL1, finalizeTx); err != nil {
+			return nil, fmt.Errorf("failed to finalize withdrawal: %w", err)
+		}
+	}
+
+	opts.Nonce = nil
+	deposit, err := t.target.Deposit(&opts, DepositTransaction{
+		To:               t.To,
+		Token:            t.Token,
+		Amount:           t.Amount,
+		ApproveERC20:     true,
+		ApproveBaseERC20: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to deposit: %w", err)
+	}
+	t.mu.Lock()
+	t.deposit = deposit
+	t.mu.Unlock()
+	return deposit, nil
+}
+
+// waitMinedL1 waits for the L1 transaction to be mined and checks that it succeeded.
+func waitMinedL1(ctx context.Context, clientL1 *ethclient.Client, tx *types.Transaction) error {
+	receipt, err := bind.WaitMined(ctx, clientL1, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s failed", tx.Hash())
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package accounts
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// multiChainTestClient is the stand-in for the client of the ETH-based chain which serves the ETH balance.
+type multiChainTestClient struct {
+	zkSyncClient
+	chainID int64
+	balance int64
+}
+
+func (c *multiChainTestClient) ChainID(_ context.Context) (*big.Int, error) {
+	return big.NewInt(c.chainID), nil
+}
+
+func (c *multiChainTestClient) BridgeContracts(_ context.Context) (*zkTypes.BridgeContracts, error) {
+	return &zkTypes.BridgeContracts{}, nil
+}
+
+func (c *multiChainTestClient) IsEthBasedChain(_ context.Context) (bool, error) {
+	return true, nil
+}
+
+func (c *multiChainTestClient) L2TokenAddress(_ context.Context, token common.Address) (common.Address, error) {
+	return token, nil
+}
+
+func (c *multiChainTestClient) BalanceAt(_ context.Context, _ common.Address, _ *big.Int) (*big.Int, error) {
+	return big.NewInt(c.balance), nil
+}
+
+// txTestSigner is the signer which signs L1 transactions as a whole.
+type txTestSigner struct {
+	*BaseSigner
+}
+
+func (s *txTestSigner) SignTx(tx *types.Transaction, _ *big.Int) (*types.Transaction, error) {
+	return tx, nil
+}
+
+func TestMultiChainWallet(t *testing.T) {
+	baseSigner, err := NewRandomBaseSigner(1)
+	assert.NoError(t, err, "NewRandomBaseSigner should not return error")
+	signer := Signer(baseSigner)
+
+	wallet, err := NewMultiChainWallet(&signer, nil,
+		toClient(&multiChainTestClient{chainID: 271, balance: 5}),
+		toClient(&multiChainTestClient{chainID: 270, balance: 7}),
+	)
+	assert.NoError(t, err, "NewMultiChainWallet should not return error")
+	assert.Equal(t, []int64{270, 271}, wallet.ChainIDs(), "Chain IDs should be the same")
+
+	for _, chainID := range wallet.ChainIDs() {
+		adapter, errL2 := wallet.L2(chainID)
+		assert.NoError(t, errL2, "L2 should not return error")
+		assert.Equal(t, wallet.Address(), adapter.Address(), "Addresses should be the same")
+		domain := adapter.Signer().Domain()
+		assert.Equal(t, big.NewInt(chainID), domain.ChainId, "Domain should be bound to the chain")
+		assert.Equal(t, baseSigner.Domain().Name, domain.Name, "Domain names should be the same")
+	}
+	assert.Equal(t, big.NewInt(1), baseSigner.Domain().ChainId, "Domain of the signer should not be changed")
+
+	balances, err := wallet.Balances(context.Background(), utils.EthAddress, nil)
+	assert.NoError(t, err, "Balances should not return error")
+	assert.Equal(t, big.NewInt(12), balances.Total, "Total balance should be the sum of chain balances")
+	assert.Equal(t, map[int64]*big.Int{270: big.NewInt(7), 271: big.NewInt(5)}, balances.Chains, "Chain balances should be the same")
+
+	_, err = wallet.Wallet(300)
+	assert.Error(t, err, "Wallet should return error for chain which is not connected")
+	_, err = wallet.TransferCrossChain(nil, CrossChainTransferTransaction{FromChainID: 270, ToChainID: 271})
+	assert.Error(t, err, "TransferCrossChain should return error without L1 client")
+}
+
+func TestNewChainSigner(t *testing.T) {
+	baseSigner, err := NewRandomBaseSigner(1)
+	assert.NoError(t, err, "NewRandomBaseSigner should not return error")
+
+	signer := newChainSigner(baseSigner, big.NewInt(270))
+	_, ok := signer.(TxSigner)
+	assert.False(t, ok, "Signer should not implement TxSigner")
+	assert.Equal(t, big.NewInt(270), signer.Domain().ChainId, "Domain should be bound to the chain")
+
+	signer = newChainSigner(&txTestSigner{BaseSigner: baseSigner}, big.NewInt(270))
+	_, ok = signer.(TxSigner)
+	assert.True(t, ok, "Signer should implement TxSigner")
+	assert.Equal(t, big.NewInt(270), signer.Domain().ChainId, "Domain should be bound to the chain")
+	assert.Equal(t, baseSigner.Address(), signer.Address(), "Addresses should be the same")
+}
+
+func TestCrossChainTransferStatusString(t *testing.T) {
+	assert.Equal(t, "withdrawn", CrossChainWithdrawn.String(), "Status names should be the same")
+	assert.Equal(t, "unknown(100)", CrossChainTransferStatus(100).String(), "Status names should be the same")
+}
+
+func TestCrossChainTransferComplete(t *testing.T) {
+	client := &withdrawalTestClient{details: &zkTypes.TransactionDetails{Status: "included"}, block: &zkTypes.BlockDetails{}}
+	adapterL1 := &withdrawalTestAdapterL1{}
+	handle := NewWithdrawalHandle(types.NewTx(&types.DynamicFeeTx{}), 0, toClient(client), adapterL1)
+	handle.pollInterval = 10 * time.Millisecond
+	transfer := &CrossChainTransfer{Withdrawal: handle}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	completed := make(chan error)
+	go func() {
+		_, err := transfer.Complete(&TransactOpts{Context: ctx})
+		completed <- err
+	}()
+	assert.Eventually(t, func() bool {
+		transfer.mu.Lock()
+		defer transfer.mu.Unlock()
+		return transfer.completing
+	}, time.Second, time.Millisecond, "Transfer should be completed")
+
+	assert.Nil(t, transfer.Deposit(), "Deposit should not be blocked while transfer is completed")
+	status, err := transfer.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, CrossChainWithdrawing, status, "Transfer should be withdrawing")
+	_, err = transfer.Complete(nil)
+	assert.Error(t, err, "Complete should return error while transfer is completed")
+	assert.ErrorIs(t, <-completed, context.DeadlineExceeded, "Complete should return error when context is done")
+
+	hash := common.HexToHash("0x01")
+	client.details.Status = "verified"
+	client.block = &zkTypes.BlockDetails{CommitTxHash: &hash, ProveTxHash: &hash, ExecuteTxHash: &hash}
+	_, err = handle.finalize(&TransactOpts{GasLimit: 300_000})
+	assert.NoError(t, err, "finalize should not return error")
+	assert.Equal(t, uint64(300_000), adapterL1.auth.GasLimit, "Transaction options should be passed to FinalizeWithdraw")
+}
@@ -0,0 +1,200 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"sync"
+)
+
+// MultisigEnvelope is a portable container of a populated EIP-712 transaction sent from a multisig account
+// and the partial signatures of its owners. It enables collecting the signatures from several parties
+// asynchronously:
+//  1. The transaction is populated using NewMultisigEnvelope and the envelope is serialized to JSON.
+//  2. Each party signs its copy of the envelope using MultisigEnvelope.Sign, which requires only a Signer.
+//  3. The signed copies are combined using MultisigEnvelope.Merge, and once the threshold is reached,
+//     the transaction is broadcast using MultisigEnvelope.Send.
+//
+// The envelope is safe for concurrent use.
+type MultisigEnvelope struct {
+	Transaction *zkTypes.Transaction712          `json:"transaction"` // The populated transaction.
+	Owners      []common.Address                 `json:"owners"`      // The owners of the multisig account.
+	Threshold   int                              `json:"threshold"`   // The number of required signatures.
+	Signatures  map[common.Address]hexutil.Bytes `json:"signatures"`  // The collected signatures keyed by owner.
+
+	mu sync.Mutex
+}
+
+// NewMultisigEnvelope creates an envelope containing the transaction sent from the multisig account.
+// Any unset transaction fields are prepared using the TransactionBuilder of the account.
+func NewMultisigEnvelope(ctx context.Context, account *SmartAccount, owners []common.Address, threshold int, tx Transaction) (*MultisigEnvelope, error) {
+	if threshold <= 0 || threshold > len(owners) {
+		return nil, fmt.Errorf("threshold must be between 1 and %d", len(owners))
+	}
+	preparedTx, err := account.PopulateTransaction(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	return &MultisigEnvelope{
+		Transaction: preparedTx,
+		Owners:      owners,
+		Threshold:   threshold,
+		Signatures:  make(map[common.Address]hexutil.Bytes),
+	}, nil
+}
+
+// Sign signs the transaction using the signer, which must be one of the owners. It does not communicate
+// with the network.
+func (e *MultisigEnvelope) Sign(signer Signer) error {
+	if !e.isOwner(signer.Address()) {
+		return errors.New("signer is not the owner of the account")
+	}
+	digest, err := e.digest()
+	if err != nil {
+		return err
+	}
+	signature, err := signECDSA(signer, digest.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.Signatures == nil {
+		e.Signatures = make(map[common.Address]hexutil.Bytes)
+	}
+	e.Signatures[signer.Address()] = signature
+	return nil
+}
+
+// AddSignature adds the signature of the transaction produced by one of the owners outside the envelope.
+// The owner is recovered from the signature.
+func (e *MultisigEnvelope) AddSignature(signature []byte) error {
+	if _, err := e.digest(); err != nil {
+		return err
+	}
+	owner, err := e.Transaction.RecoverSigner(signature)
+	if err != nil {
+		return fmt.Errorf("failed to recover signer: %w", err)
+	}
+	if !e.isOwner(owner) {
+		return errors.New("signer is not the owner of the account")
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.Signatures == nil {
+		e.Signatures = make(map[common.Address]hexutil.Bytes)
+	}
+	e.Signatures[owner] = signature
+	return nil
+}
+
+// Merge adds the signatures collected in the other envelope. Both envelopes must contain the same transaction.
+// Each signature is verified before it is added.
+func (e *MultisigEnvelope) Merge(other *MultisigEnvelope) error {
+	digest, err := e.digest()
+	if err != nil {
+		return err
+	}
+	otherDigest, err := other.digest()
+	if err != nil {
+		return err
+	}
+	if digest != otherDigest {
+		return errors.New("envelopes contain different transactions")
+	}
+
+	other.mu.Lock()
+	signatures := make([]hexutil.Bytes, 0, len(other.Signatures))
+	for _, signature := range other.Signatures {
+		signatures = append(signatures, signature)
+	}
+	other.mu.Unlock()
+
+	for _, signature := range signatures {
+		if err = e.AddSignature(signature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsComplete returns true if the envelope contains the number of signatures required by the threshold.
+func (e *MultisigEnvelope) IsComplete() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.Threshold > 0 && len(e.Signatures) >= e.Threshold
+}
+
+// Signature returns the custom signature of the transaction that consists of the first Threshold collected
+// signatures, ordered by the owner address and concatenated in the same order.
+func (e *MultisigEnvelope) Signature() ([]byte, error) {
+	if !e.IsComplete() {
+		return nil, errors.New("not enough signatures collected")
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	signatures := make(map[common.Address][]byte, len(e.Signatures))
+	for owner, signature := range e.Signatures {
+		signatures[owner] = signature
+	}
+	signature := concatSignatures(signatures)
+	return signature[:65*e.Threshold], nil
+}
+
+// RawTransaction returns the transaction with the merged signatures set as the custom signature,
+// which is ready to be broadcast to the network.
+func (e *MultisigEnvelope) RawTransaction() ([]byte, error) {
+	if e.Transaction == nil {
+		return nil, errors.New("envelope does not contain transaction")
+	}
+	signature, err := e.Signature()
+	if err != nil {
+		return nil, err
+	}
+	tx := *e.Transaction
+	meta := zkTypes.Eip712Meta{}
+	if tx.Meta != nil {
+		meta = *tx.Meta
+	}
+	meta.CustomSignature = signature
+	tx.Meta = &meta
+	return tx.RLPValues(nil)
+}
+
+// Send broadcasts the transaction with the merged signatures to the network.
+func (e *MultisigEnvelope) Send(ctx context.Context, client *clients.Client) (common.Hash, error) {
+	if client == nil {
+		return common.Hash{}, errors.New("client must be provided")
+	}
+	rawTx, err := e.RawTransaction()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return (*client).SendRawTransaction(ensureContext(ctx), rawTx)
+}
+
+func (e *MultisigEnvelope) digest() (common.Hash, error) {
+	if e.Transaction == nil {
+		return common.Hash{}, errors.New("envelope does not contain transaction")
+	}
+	if e.Transaction.From == nil {
+		return common.Hash{}, errors.New("transaction sender must be provided")
+	}
+	return e.Transaction.SignedDigest()
+}
+
+func (e *MultisigEnvelope) isOwner(address common.Address) bool {
+	for _, owner := range e.Owners {
+		if owner == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"sync"
+	"testing"
+)
+
+func TestMultisigEnvelope(t *testing.T) {
+	signers := make([]Signer, 3)
+	owners := make([]common.Address, 3)
+	for i := range signers {
+		signer, err := NewRandomBaseSigner(270)
+		assert.NoError(t, err, "NewRandomBaseSigner should not return error")
+		signers[i] = signer
+		owners[i] = signer.Address()
+	}
+	account := common.HexToAddress("0x0a67078A35745947A37A552174aFe724D8180c25")
+	tx := newTestSmartAccountTransaction()
+	tx.From = &account
+	envelope := &MultisigEnvelope{Transaction: tx, Owners: owners, Threshold: 2}
+
+	data, err := json.Marshal(envelope)
+	assert.NoError(t, err, "Marshal should not return error")
+
+	// each party signs its own copy of the envelope
+	var wg sync.WaitGroup
+	partials := make([]*MultisigEnvelope, 2)
+	for i := range partials {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var partial MultisigEnvelope
+			assert.NoError(t, json.Unmarshal(data, &partial), "Unmarshal should not return error")
+			assert.NoError(t, partial.Sign(signers[i]), "Sign should not return error")
+			partials[i] = &partial
+		}(i)
+	}
+	wg.Wait()
+
+	assert.False(t, envelope.IsComplete(), "Envelope should not be complete")
+	_, err = envelope.RawTransaction()
+	assert.Error(t, err, "RawTransaction should return error when envelope is not complete")
+
+	for _, partial := range partials {
+		assert.NoError(t, envelope.Merge(partial), "Merge should not return error")
+	}
+	assert.True(t, envelope.IsComplete(), "Envelope should be complete")
+
+	digest, err := tx.SignedDigest()
+	assert.NoError(t, err, "SignedDigest should not return error")
+	expected, err := SignPayloadWithMultipleECDSA(context.Background(), digest.Bytes(),
+		&MultisigECDSASecret{Signers: signers[:2], Threshold: 2}, nil)
+	assert.NoError(t, err, "SignPayloadWithMultipleECDSA should not return error")
+
+	rawTx, err := envelope.RawTransaction()
+	assert.NoError(t, err, "RawTransaction should not return error")
+	decoded, signature, err := zkTypes.DecodeTransaction712(rawTx)
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	assert.Equal(t, expected, signature, "Merged signature should match multisig signature")
+	assert.Equal(t, account, *decoded.From, "Sender should be the multisig account")
+}
+
+func TestMultisigEnvelopeSignNotOwner(t *testing.T) {
+	signer, err := NewRandomBaseSigner(270)
+	assert.NoError(t, err, "NewRandomBaseSigner should not return error")
+
+	envelope := &MultisigEnvelope{
+		Transaction: newTestSmartAccountTransaction(),
+		Owners:      []common.Address{common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049")},
+		Threshold:   1,
+	}
+	assert.Error(t, envelope.Sign(signer), "Sign should return error when signer is not the owner")
+}
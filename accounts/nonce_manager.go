@@ -0,0 +1,294 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	"github.com/zksync-sdk/zksync2-go/contracts/nonceholder"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// NonceManager allocates the nonces of the transactions sent from the account. It allows sending
+// transactions concurrently without fetching the nonce from the network for every transaction.
+// Implementations must be safe for concurrent use.
+type NonceManager interface {
+	// Next allocates the nonce for the next transaction.
+	Next(ctx context.Context) (*big.Int, error)
+	// Done reports the result of sending the transaction with the allocated nonce.
+	// The err is nil if the transaction is accepted by the network.
+	Done(ctx context.Context, nonce *big.Int, err error)
+	// Gaps returns the allocated nonces whose transactions are not accepted by the network,
+	// and therefore block the execution of the transactions with higher nonces.
+	Gaps() []*big.Int
+	// Reset discards the locally tracked nonces, so the next allocation is synced with the network.
+	Reset()
+}
+
+// NewNonceManager creates a new instance of NonceManager for the account, which matches the nonce ordering
+// of the account reported by ContractAccountInfo.
+func NewNonceManager(ctx context.Context, client *clients.Client, address common.Address) (NonceManager, error) {
+	info, err := (*client).ContractAccountInfo(ensureContext(ctx), address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account info: %w", err)
+	}
+	if info.NonceOrdering == zkTypes.Arbitrary {
+		return NewArbitraryNonceManager(client, address), nil
+	}
+	return NewSequentialNonceManager(client, address), nil
+}
+
+// SequentialNonceManager is a NonceManager for accounts with the Sequential nonce ordering.
+// The nonces are allocated locally starting from the pending nonce of the account. If sending the transaction fails,
+// its nonce becomes a gap that is reused by the next allocation. The nonces are synced with the network
+// whenever the transaction is rejected because its nonce is too low or too high.
+type SequentialNonceManager struct {
+	client  *clients.Client
+	address common.Address
+
+	mu     sync.Mutex
+	synced bool
+	next   uint64
+	gaps   map[uint64]struct{}
+}
+
+// NewSequentialNonceManager creates a new instance of SequentialNonceManager for the account.
+func NewSequentialNonceManager(client *clients.Client, address common.Address) *SequentialNonceManager {
+	return &SequentialNonceManager{
+		client:  client,
+		address: address,
+		gaps:    make(map[uint64]struct{}),
+	}
+}
+
+func (m *SequentialNonceManager) Next(ctx context.Context) (*big.Int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.synced {
+		nonce, err := (*m.client).PendingNonceAt(ensureContext(ctx), m.address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get nonce: %w", err)
+		}
+		m.next = nonce
+		m.synced = true
+	}
+	if len(m.gaps) > 0 {
+		gap := m.sortedGaps()[0]
+		delete(m.gaps, gap)
+		return new(big.Int).SetUint64(gap), nil
+	}
+	nonce := m.next
+	m.next++
+	return new(big.Int).SetUint64(nonce), nil
+}
+
+func (m *SequentialNonceManager) Done(_ context.Context, nonce *big.Int, err error) {
+	if err == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if isNonceError(err) {
+		m.reset()
+		return
+	}
+	if !m.synced || !nonce.IsUint64() || nonce.Uint64() >= m.next {
+		return
+	}
+	m.gaps[nonce.Uint64()] = struct{}{}
+	// the gaps at the end of the allocated range can be released
+	for m.next > 0 {
+		if _, ok := m.gaps[m.next-1]; !ok {
+			break
+		}
+		delete(m.gaps, m.next-1)
+		m.next--
+	}
+}
+
+func (m *SequentialNonceManager) Gaps() []*big.Int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	gaps := m.sortedGaps()
+	result := make([]*big.Int, len(gaps))
+	for i, gap := range gaps {
+		result[i] = new(big.Int).SetUint64(gap)
+	}
+	return result
+}
+
+func (m *SequentialNonceManager) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reset()
+}
+
+func (m *SequentialNonceManager) reset() {
+	m.synced = false
+	m.gaps = make(map[uint64]struct{})
+}
+
+func (m *SequentialNonceManager) sortedGaps() []uint64 {
+	gaps := make([]uint64, 0, len(m.gaps))
+	for gap := range m.gaps {
+		gaps = append(gaps, gap)
+	}
+	sort.Slice(gaps, func(i, j int) bool { return gaps[i] < gaps[j] })
+	return gaps
+}
+
+// ArbitraryNonceManager is a NonceManager for accounts with the Arbitrary nonce ordering.
+// Such accounts can use any nonce that is not lower than the minimal nonce and is not used yet,
+// so the nonces are allocated by checking their usage in the NonceHolder system contract.
+// Since the transactions do not depend on each other, failed transactions never create gaps.
+type ArbitraryNonceManager struct {
+	client  *clients.Client
+	address common.Address
+
+	mu        sync.Mutex
+	synced    bool
+	cursor    *big.Int
+	allocated map[string]*big.Int // The nonces that are allocated to the transactions which are not sent yet.
+}
+
+// NewArbitraryNonceManager creates a new instance of ArbitraryNonceManager for the account.
+func NewArbitraryNonceManager(client *clients.Client, address common.Address) *ArbitraryNonceManager {
+	return &ArbitraryNonceManager{
+		client:    client,
+		address:   address,
+		allocated: make(map[string]*big.Int),
+	}
+}
+
+// Next allocates the lowest nonce that is neither allocated nor used. The NonceHolder is queried
+// without holding the lock, so concurrent allocations are not serialized behind the network calls.
+func (m *ArbitraryNonceManager) Next(ctx context.Context) (*big.Int, error) {
+	nonceHolder, err := nonceholder.NewINonceHolder(utils.NonceHolderAddress, *m.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load NonceHolder: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ensureContext(ctx)}
+	m.mu.Lock()
+	synced := m.synced
+	m.mu.Unlock()
+	if !synced {
+		minNonce, errMin := nonceHolder.GetMinNonce(opts, m.address)
+		if errMin != nil {
+			return nil, fmt.Errorf("failed to get min nonce: %w", errMin)
+		}
+		m.mu.Lock()
+		if !m.synced {
+			m.cursor = minNonce
+			m.allocated = make(map[string]*big.Int)
+			m.synced = true
+		}
+		m.mu.Unlock()
+	}
+	for {
+		// the candidate is reserved before its usage is checked, so it is not checked by concurrent allocations
+		nonce := m.reserve()
+		used, errUsed := nonceHolder.IsNonceUsed(opts, m.address, nonce)
+		if errUsed != nil {
+			m.release(nonce)
+			return nil, fmt.Errorf("failed to check nonce usage: %w", errUsed)
+		}
+		if !used {
+			return nonce, nil
+		}
+		m.mu.Lock()
+		delete(m.allocated, nonce.String())
+		m.mu.Unlock()
+	}
+}
+
+// Done releases the nonce of the failed transaction. Once the transaction is accepted, the nonce is
+// discarded, since the NonceHolder reports it as used, so it is not allocated again.
+func (m *ArbitraryNonceManager) Done(_ context.Context, nonce *big.Int, err error) {
+	if isNonceError(err) {
+		m.Reset()
+		return
+	}
+	if err != nil {
+		// the nonce is not used, so it can be allocated again
+		m.release(nonce)
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.allocated, nonce.String())
+}
+
+// reserve moves the cursor past the next nonce that is not allocated, and marks that nonce as allocated.
+func (m *ArbitraryNonceManager) reserve() *big.Int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for {
+		nonce := new(big.Int).Set(m.cursor)
+		m.cursor.Add(m.cursor, big.NewInt(1))
+		if _, ok := m.allocated[nonce.String()]; !ok {
+			m.allocated[nonce.String()] = nonce
+			return nonce
+		}
+	}
+}
+
+// release makes the nonce available for allocation again.
+func (m *ArbitraryNonceManager) release(nonce *big.Int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.synced {
+		return
+	}
+	delete(m.allocated, nonce.String())
+	if nonce.Cmp(m.cursor) < 0 {
+		m.cursor = new(big.Int).Set(nonce)
+	}
+}
+
+// Gaps always returns nil, since the transactions with arbitrary nonces do not block each other.
+func (m *ArbitraryNonceManager) Gaps() []*big.Int {
+	return nil
+}
+
+func (m *ArbitraryNonceManager) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.synced = false
+}
+
+// isNonceError checks whether the transaction is rejected because its nonce is too low or too high.
+func isNonceError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "nonce too low") || strings.Contains(msg, "nonce too high")
+}
+
+// allocateNonce allocates the nonce using the manager if the nonce is not set, and returns the function
+// that reports the result of sending the transaction to the manager.
+func allocateNonce(ctx context.Context, manager NonceManager, nonce **big.Int) (func(error), error) {
+	if manager == nil || *nonce != nil {
+		return func(error) {}, nil
+	}
+	allocated, err := manager.Next(ensureContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate nonce: %w", err)
+	}
+	*nonce = allocated
+	return func(err error) {
+		manager.Done(ensureContext(ctx), allocated, err)
+	}, nil
+}
+
+var errNonceManagerNotSet = errors.New("nonce manager is not set")
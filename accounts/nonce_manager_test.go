@@ -0,0 +1,220 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	"github.com/zksync-sdk/zksync2-go/contracts/nonceholder"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+// zkSyncClient allows embedding clients.Client, whose method set contains the Client method.
+type zkSyncClient = clients.Client
+
+// nonceTestClient is the stand-in for the client which serves the nonce of the account.
+type nonceTestClient struct {
+	zkSyncClient
+	pendingNonce uint64
+	minNonce     int64
+	usedNonces   map[int64]bool
+}
+
+func (c *nonceTestClient) PendingNonceAt(_ context.Context, _ common.Address) (uint64, error) {
+	return c.pendingNonce, nil
+}
+
+func (c *nonceTestClient) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	nonceHolderAbi, err := nonceholder.INonceHolderMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := nonceHolderAbi.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "getMinNonce":
+		return method.Outputs.Pack(big.NewInt(c.minNonce))
+	case "isNonceUsed":
+		args, errUnpack := method.Inputs.Unpack(msg.Data[4:])
+		if errUnpack != nil {
+			return nil, errUnpack
+		}
+		return method.Outputs.Pack(c.usedNonces[args[1].(*big.Int).Int64()])
+	}
+	return nil, errors.New("unexpected call")
+}
+
+func newNonceTestClient(c *nonceTestClient) *clients.Client {
+	return toClient(c)
+}
+
+func toClient(c clients.Client) *clients.Client {
+	return &c
+}
+
+func TestSequentialNonceManagerConcurrent(t *testing.T) {
+	manager := NewSequentialNonceManager(newNonceTestClient(&nonceTestClient{pendingNonce: 10}), common.Address{})
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		nonces = make(map[uint64]bool)
+	)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, err := manager.Next(context.Background())
+			assert.NoError(t, err, "Next should not return error")
+			manager.Done(context.Background(), nonce, nil)
+			mu.Lock()
+			nonces[nonce.Uint64()] = true
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, nonces, 100, "Nonces should be unique")
+	for i := uint64(10); i < 110; i++ {
+		assert.True(t, nonces[i], "Nonces should be allocated sequentially from the pending nonce")
+	}
+}
+
+func TestSequentialNonceManagerGaps(t *testing.T) {
+	client := &nonceTestClient{pendingNonce: 5}
+	manager := NewSequentialNonceManager(newNonceTestClient(client), common.Address{})
+	ctx := context.Background()
+
+	nonces := make([]*big.Int, 3)
+	for i := range nonces {
+		nonce, err := manager.Next(ctx)
+		assert.NoError(t, err, "Next should not return error")
+		nonces[i] = nonce
+	}
+
+	// failure of the last allocated nonce does not create a gap
+	manager.Done(ctx, nonces[2], errors.New("insufficient balance"))
+	assert.Empty(t, manager.Gaps(), "Gaps should be empty")
+
+	manager.Done(ctx, nonces[0], errors.New("insufficient balance"))
+	manager.Done(ctx, nonces[1], nil)
+	assert.Equal(t, []*big.Int{big.NewInt(5)}, manager.Gaps(), "Gap should be detected")
+
+	nonce, err := manager.Next(ctx)
+	assert.NoError(t, err, "Next should not return error")
+	assert.Equal(t, big.NewInt(5), nonce, "Gap should be allocated first")
+	assert.Empty(t, manager.Gaps(), "Gaps should be empty")
+
+	nonce, err = manager.Next(ctx)
+	assert.NoError(t, err, "Next should not return error")
+	assert.Equal(t, big.NewInt(7), nonce, "Next nonce should follow the allocated nonces")
+
+	// another sender used the nonces of the account
+	client.pendingNonce = 20
+	manager.Done(ctx, nonce, errors.New("nonce too low. allowed nonce range: 20 - 60, actual: 7"))
+	nonce, err = manager.Next(ctx)
+	assert.NoError(t, err, "Next should not return error")
+	assert.Equal(t, big.NewInt(20), nonce, "Nonce should be synced with the network")
+}
+
+func TestArbitraryNonceManager(t *testing.T) {
+	client := &nonceTestClient{
+		minNonce:   3,
+		usedNonces: map[int64]bool{4: true, 6: true},
+	}
+	manager := NewArbitraryNonceManager(newNonceTestClient(client), common.Address{})
+	ctx := context.Background()
+
+	var allocated []*big.Int
+	for i := 0; i < 3; i++ {
+		nonce, err := manager.Next(ctx)
+		assert.NoError(t, err, "Next should not return error")
+		allocated = append(allocated, nonce)
+	}
+	assert.Equal(t, []*big.Int{big.NewInt(3), big.NewInt(5), big.NewInt(7)}, allocated, "Used nonces should be skipped")
+
+	manager.Done(ctx, allocated[1], errors.New("insufficient balance"))
+	assert.Empty(t, manager.Gaps(), "Arbitrary nonces should not create gaps")
+	nonce, err := manager.Next(ctx)
+	assert.NoError(t, err, "Next should not return error")
+	assert.Equal(t, big.NewInt(5), nonce, "Released nonce should be allocated again")
+
+	nonce, err = manager.Next(ctx)
+	assert.NoError(t, err, "Next should not return error")
+	assert.Equal(t, big.NewInt(8), nonce, "Allocated nonce should not be allocated again")
+
+	for _, accepted := range []*big.Int{allocated[0], big.NewInt(5), allocated[2], nonce} {
+		manager.Done(ctx, accepted, nil)
+		client.usedNonces[accepted.Int64()] = true
+	}
+	assert.Empty(t, manager.allocated, "Nonces of accepted transactions should be discarded")
+	nonce, err = manager.Next(ctx)
+	assert.NoError(t, err, "Next should not return error")
+	assert.Equal(t, big.NewInt(9), nonce, "Used nonces should not be allocated again")
+}
+
+func TestArbitraryNonceManagerConcurrent(t *testing.T) {
+	manager := NewArbitraryNonceManager(newNonceTestClient(&nonceTestClient{
+		usedNonces: map[int64]bool{2: true, 5: true},
+	}), common.Address{})
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		nonces = make(map[int64]struct{})
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, err := manager.Next(context.Background())
+			assert.NoError(t, err, "Next should not return error")
+			mu.Lock()
+			nonces[nonce.Int64()] = struct{}{}
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, nonces, 20, "Nonces should be unique")
+	assert.NotContains(t, nonces, int64(2), "Used nonce should be skipped")
+	assert.NotContains(t, nonces, int64(5), "Used nonce should be skipped")
+}
+
+func TestAllocateNonce(t *testing.T) {
+	manager := NewSequentialNonceManager(newNonceTestClient(&nonceTestClient{pendingNonce: 1}), common.Address{})
+
+	provided := big.NewInt(100)
+	nonce := provided
+	_, err := allocateNonce(context.Background(), manager, &nonce)
+	assert.NoError(t, err, "allocateNonce should not return error")
+	assert.Equal(t, provided, nonce, "Provided nonce should not be replaced")
+
+	nonce = nil
+	done, err := allocateNonce(context.Background(), manager, &nonce)
+	assert.NoError(t, err, "allocateNonce should not return error")
+	assert.Equal(t, big.NewInt(1), nonce, "Nonce should be allocated by manager")
+	done(errors.New("nonce too high"))
+	assert.Empty(t, manager.Gaps(), "Gaps should be empty after resync")
+}
+
+func TestWalletNonceManagerCustomAdapter(t *testing.T) {
+	wallet := &Wallet{AdapterL2: &WalletL2{}}
+	manager := NewSequentialNonceManager(nil, common.Address{})
+	assert.NoError(t, wallet.SetNonceManager(manager), "SetNonceManager should not return error")
+	assert.Equal(t, manager, wallet.AdapterL2.(*WalletL2).nonceManager, "Nonce managers should be the same")
+
+	// customAdapterL2 is the user-supplied adapter that does not manage the nonces.
+	type customAdapterL2 struct {
+		AdapterL2
+	}
+	wallet = &Wallet{AdapterL2: customAdapterL2{}}
+	assert.Error(t, wallet.SetNonceManager(manager), "SetNonceManager should return error for custom adapter")
+	_, err := wallet.FillNonceGaps(context.Background())
+	assert.Error(t, err, "FillNonceGaps should return error for custom adapter")
+}
@@ -0,0 +1,246 @@
+package accounts
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/zksync-sdk/zksync2-go/eip712"
+	"math/big"
+	"time"
+)
+
+// RemoteSignerAPI defines the JSON-RPC methods of the remote signing service used by RemoteSigner.
+type RemoteSignerAPI struct {
+	// SignHash signs the raw 32-byte hash. The parameters are the address and the hash,
+	// and the result is the signature. Leave empty if the service does not sign raw hashes.
+	SignHash string
+	// SignTypedData signs the EIP-712 typed data. The parameters are the address and the typed data,
+	// and the result is the signature.
+	SignTypedData string
+	// SignTransaction signs the L1 transaction. The parameter is the transaction arguments, and the result
+	// is either the raw signed transaction or an object containing it in the raw field.
+	// Leave empty if the service does not sign transactions.
+	SignTransaction string
+}
+
+var (
+	// ClefAPI is the external API of the Clef signer.
+	ClefAPI = RemoteSignerAPI{
+		SignTypedData:   "account_signTypedData",
+		SignTransaction: "account_signTransaction",
+	}
+	// Web3SignerAPI is the Ethereum JSON-RPC API of the Web3Signer.
+	Web3SignerAPI = RemoteSignerAPI{
+		SignTypedData:   "eth_signTypedData",
+		SignTransaction: "eth_signTransaction",
+	}
+)
+
+// DefaultRemoteSignerTimeout is the default maximum duration of a single request to the signing service,
+// which leaves time for the interactive approval of the request, e.g. in Clef.
+const DefaultRemoteSignerTimeout = 5 * time.Minute
+
+// RemoteSigner is a Signer that delegates signing to the external signing service over JSON-RPC,
+// so the private key never leaves the service. EIP-712 transactions are signed using the SignTypedData
+// method of the service, and L1 transactions are signed using the SignTransaction method,
+// which makes the signer usable with both WalletL1 and WalletL2.
+type RemoteSigner struct {
+	// Timeout is the maximum duration of a single request to the signing service. Defaults to
+	// DefaultRemoteSignerTimeout; if it is not positive, the requests are not limited in time.
+	Timeout time.Duration
+
+	client  *rpc.Client
+	api     RemoteSignerAPI
+	address common.Address
+	domain  *eip712.Domain
+}
+
+// NewRemoteSigner creates a new instance of RemoteSigner for the account managed by the signing service
+// that is accessible through the client.
+func NewRemoteSigner(client *rpc.Client, api RemoteSignerAPI, address common.Address, chainId int64) *RemoteSigner {
+	return &RemoteSigner{
+		Timeout: DefaultRemoteSignerTimeout,
+		client:  client,
+		api:     api,
+		address: address,
+		domain:  eip712.ZkSyncEraEIP712Domain(chainId),
+	}
+}
+
+// DialRemoteSigner connects to the signing service at the given URL and creates a new instance of RemoteSigner
+// for the account managed by the service.
+func DialRemoteSigner(rawUrl string, api RemoteSignerAPI, address common.Address, chainId int64) (*RemoteSigner, error) {
+	client, err := rpc.Dial(rawUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer: %w", err)
+	}
+	return NewRemoteSigner(client, api, address, chainId), nil
+}
+
+// Close closes the underlying RPC connection.
+func (s *RemoteSigner) Close() {
+	s.client.Close()
+}
+
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *RemoteSigner) Domain() *eip712.Domain {
+	return s.domain
+}
+
+// PrivateKey always returns nil, since the private key is managed by the signing service.
+func (s *RemoteSigner) PrivateKey() *ecdsa.PrivateKey {
+	return nil
+}
+
+func (s *RemoteSigner) SignHash(msg []byte) ([]byte, error) {
+	if s.api.SignHash == "" {
+		return nil, errors.New("remote signer does not support signing hashes")
+	}
+	var sig hexutil.Bytes
+	if err := s.call(&sig, s.api.SignHash, s.address, hexutil.Bytes(msg)); err != nil {
+		return nil, fmt.Errorf("failed to sign hash: %w", err)
+	}
+	if len(sig) != 65 {
+		return nil, fmt.Errorf("invalid signature length: %d", len(sig))
+	}
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	return sig, nil
+}
+
+func (s *RemoteSigner) SignTypedData(domain *eip712.Domain, data eip712.TypedData) ([]byte, error) {
+	if s.api.SignTypedData == "" {
+		return nil, errors.New("remote signer does not support signing typed data")
+	}
+	typedData, err := eip712.BuildTypedData(domain, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get typed data: %w", err)
+	}
+	typedData.Message = encodeTypedDataValue(typedData.Message).(map[string]interface{})
+
+	var sig hexutil.Bytes
+	if err = s.call(&sig, s.api.SignTypedData, s.address, typedData); err != nil {
+		return nil, fmt.Errorf("failed to sign typed data: %w", err)
+	}
+	if len(sig) != 65 {
+		return nil, fmt.Errorf("invalid signature length: %d", len(sig))
+	}
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+	return sig, nil
+}
+
+// call calls the method of the signing service, which fails if the service does not respond within the Timeout.
+func (s *RemoteSigner) call(result interface{}, method string, args ...interface{}) error {
+	ctx := context.Background()
+	if s.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.Timeout)
+		defer cancel()
+	}
+	return s.client.CallContext(ctx, result, method, args...)
+}
+
+// SignTx signs the legacy or EIP-1559 transaction using the SignTransaction method of the service.
+// If the service does not sign transactions, the transaction hash is signed using SignHash.
+func (s *RemoteSigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signer := types.LatestSignerForChainID(chainID)
+	if s.api.SignTransaction == "" {
+		sig, err := s.SignHash(signer.Hash(tx).Bytes())
+		if err != nil {
+			return nil, err
+		}
+		return tx.WithSignature(signer, sig)
+	}
+
+	data := hexutil.Bytes(tx.Data())
+	args := apitypes.SendTxArgs{
+		From:    common.NewMixedcaseAddress(s.address),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    &data,
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.To() != nil {
+		to := common.NewMixedcaseAddress(*tx.To())
+		args.To = &to
+	}
+	if tx.Type() == types.LegacyTxType {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	} else {
+		accessList := tx.AccessList()
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		args.AccessList = &accessList
+	}
+
+	var result json.RawMessage
+	if err := s.call(&result, s.api.SignTransaction, args); err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		var object struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err = json.Unmarshal(result, &object); err != nil {
+			return nil, fmt.Errorf("failed to decode signed transaction: %w", err)
+		}
+		raw = object.Raw
+	}
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode signed transaction: %w", err)
+	}
+
+	// ensure that the service signed exactly the requested transaction with the expected account
+	if signer.Hash(signedTx) != signer.Hash(tx) {
+		return nil, errors.New("remote signer modified the transaction")
+	}
+	sender, err := types.Sender(signer, signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover sender of signed transaction: %w", err)
+	}
+	if sender != s.address {
+		return nil, errors.New("remote signer signed the transaction with different account")
+	}
+	return signedTx, nil
+}
+
+// encodeTypedDataValue converts the values of the typed data message into the form that is preserved
+// through the JSON encoding.
+func encodeTypedDataValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []byte:
+		return hexutil.Bytes(v)
+	case *big.Int:
+		return v.String()
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = encodeTypedDataValue(item)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = encodeTypedDataValue(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
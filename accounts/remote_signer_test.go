@@ -0,0 +1,198 @@
+package accounts
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// clefService is the in-process stand-in for the external API of the Clef signer.
+type clefService struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *clefService) SignTypedData(_ context.Context, _ common.MixedcaseAddress, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(hash, s.key)
+	if err != nil {
+		return nil, err
+	}
+	sig[64] += 27
+	return sig, nil
+}
+
+func (s *clefService) SignTransaction(_ context.Context, args apitypes.SendTxArgs) (map[string]interface{}, error) {
+	tx := args.ToTransaction()
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(args.ChainID.ToInt()), s.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": signedTx}, nil
+}
+
+// hashService is the in-process stand-in for the signing service that signs raw hashes only.
+type hashService struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *hashService) SignHash(_ common.Address, hash hexutil.Bytes) (hexutil.Bytes, error) {
+	sig, err := crypto.Sign(hash, s.key)
+	if err != nil {
+		return nil, err
+	}
+	sig[64] += 27
+	return sig, nil
+}
+
+func newTestRemoteSigner(t *testing.T, namespace string, service interface{}, api RemoteSignerAPI) (*RemoteSigner, *BaseSigner) {
+	baseSigner, err := NewBaseSignerFromRawPrivateKey(common.Hex2Bytes("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110"), 270)
+	assert.NoError(t, err, "NewBaseSignerFromRawPrivateKey should not return error")
+
+	server := rpc.NewServer()
+	t.Cleanup(server.Stop)
+	switch s := service.(type) {
+	case *clefService:
+		s.key = baseSigner.PrivateKey()
+	case *hashService:
+		s.key = baseSigner.PrivateKey()
+	}
+	err = server.RegisterName(namespace, service)
+	assert.NoError(t, err, "RegisterName should not return error")
+
+	signer := NewRemoteSigner(rpc.DialInProc(server), api, baseSigner.Address(), 270)
+	t.Cleanup(signer.Close)
+	return signer, baseSigner
+}
+
+func TestRemoteSignerSignTypedData(t *testing.T) {
+	signer, baseSigner := newTestRemoteSigner(t, "account", &clefService{}, ClefAPI)
+	assert.Nil(t, signer.PrivateKey(), "Private key should not be revealed")
+
+	tx := newTestSmartAccountTransaction()
+	from := baseSigner.Address()
+	tx.From = &from
+	tx.Data = hexutil.MustDecode("0xa9059cbb")
+	tx.Meta.FactoryDeps = []hexutil.Bytes{make([]byte, 64)}
+	tx.Meta.PaymasterParams = &zkTypes.PaymasterParams{
+		Paymaster:      common.HexToAddress("0x0a67078A35745947A37A552174aFe724D8180c25"),
+		PaymasterInput: common.Hex2Bytes("8c5a3445"),
+	}
+
+	signature, err := signer.SignTypedData(signer.Domain(), tx)
+	assert.NoError(t, err, "SignTypedData should not return error")
+	expected, err := baseSigner.SignTypedData(baseSigner.Domain(), tx)
+	assert.NoError(t, err, "SignTypedData should not return error")
+	assert.Equal(t, expected, signature, "Signatures should be the same")
+
+	// sign EIP-712 transaction the same way as WalletL2 does
+	s := Signer(signer)
+	wallet, err := NewWalletL2FromSigner(&s, nil)
+	assert.NoError(t, err, "NewWalletL2FromSigner should not return error")
+	rawTx, err := wallet.SignTransaction(tx)
+	assert.NoError(t, err, "SignTransaction should not return error")
+	decoded, decodedSignature, err := zkTypes.DecodeTransaction712(rawTx)
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	sender, err := decoded.RecoverSigner(decodedSignature)
+	assert.NoError(t, err, "RecoverSigner should not return error")
+	assert.Equal(t, baseSigner.Address(), sender, "Sender should be the remote account")
+
+	_, err = signer.SignHash(crypto.Keccak256([]byte("Hello, zkSync!")))
+	assert.Error(t, err, "SignHash should return error when service does not sign hashes")
+}
+
+func TestRemoteSignerSignTx(t *testing.T) {
+	signer, baseSigner := newTestRemoteSigner(t, "account", &clefService{}, ClefAPI)
+	to := common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618")
+	chainID := big.NewInt(9)
+
+	// sign L1 transactions the same way as WalletL1 does
+	auth, err := newTransactorWithSigner(toSigner(signer), chainID)
+	assert.NoError(t, err, "newTransactorWithSigner should not return error")
+	for _, tx := range []*types.Transaction{
+		types.NewTx(&types.LegacyTx{
+			Nonce:    1,
+			GasPrice: big.NewInt(1_000_000_000),
+			Gas:      21_000,
+			To:       &to,
+			Value:    big.NewInt(1_000),
+		}),
+		types.NewTx(&types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     2,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(1_000_000_000),
+			Gas:       50_000,
+			To:        &to,
+			Value:     big.NewInt(1_000),
+			Data:      hexutil.MustDecode("0xa9059cbb"),
+		}),
+	} {
+		signedTx, errSign := auth.Signer(signer.Address(), tx)
+		assert.NoError(t, errSign, "Signer should not return error")
+		sender, errSender := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+		assert.NoError(t, errSender, "Sender should not return error")
+		assert.Equal(t, baseSigner.Address(), sender, "Sender should be the remote account")
+		assert.Equal(t, tx.Type(), signedTx.Type(), "Transaction types should be the same")
+		assert.Equal(t, tx.Nonce(), signedTx.Nonce(), "Nonces should be the same")
+	}
+}
+
+func TestRemoteSignerSignHash(t *testing.T) {
+	signer, baseSigner := newTestRemoteSigner(t, "signer", &hashService{}, RemoteSignerAPI{SignHash: "signer_signHash"})
+
+	hash := crypto.Keccak256([]byte("Hello, zkSync!"))
+	signature, err := signer.SignHash(hash)
+	assert.NoError(t, err, "SignHash should not return error")
+	expected, err := baseSigner.SignHash(hash)
+	assert.NoError(t, err, "SignHash should not return error")
+	assert.Equal(t, expected, signature, "Signatures should be the same")
+
+	// without the transaction signing method, the transaction hash is signed
+	chainID := big.NewInt(9)
+	to := common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618")
+	signedTx, err := signer.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		GasFeeCap: big.NewInt(1_000_000_000),
+		Gas:       21_000,
+		To:        &to,
+	}), chainID)
+	assert.NoError(t, err, "SignTx should not return error")
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+	assert.NoError(t, err, "Sender should not return error")
+	assert.Equal(t, baseSigner.Address(), sender, "Sender should be the remote account")
+}
+
+// stalledService is the in-process stand-in for the signing service that waits for the approval
+// which is never given.
+type stalledService struct{}
+
+func (s *stalledService) SignHash(ctx context.Context, _ common.Address, _ hexutil.Bytes) (hexutil.Bytes, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestRemoteSignerTimeout(t *testing.T) {
+	signer, _ := newTestRemoteSigner(t, "signer", &stalledService{}, RemoteSignerAPI{SignHash: "signer_signHash"})
+	assert.Equal(t, DefaultRemoteSignerTimeout, signer.Timeout, "Timeouts should be the same")
+
+	signer.Timeout = 50 * time.Millisecond
+	_, err := signer.SignHash(crypto.Keccak256([]byte("Hello, zkSync!")))
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "SignHash should return error when service does not respond")
+}
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/pkg/errors"
 	"github.com/stephenlacy/go-ethereum-hdwallet"
 	"github.com/zksync-sdk/zksync2-go/eip712"
+	"math/big"
 )
 
 // Signer provides support for signing EIP-712 transactions as well as other types of transactions supported by
@@ -19,7 +21,8 @@ type Signer interface {
 	Address() common.Address
 	// Domain returns the EIP-712 domain used for signing.
 	Domain() *eip712.Domain
-	// PrivateKey returns the private key associated with the signer.
+	// PrivateKey returns the private key associated with the signer. It returns nil if the signer
+	// can not reveal the private key, such as KeystoreSigner.
 	PrivateKey() *ecdsa.PrivateKey
 	// SignHash signs the given hash using the signer's private key and returns the signature.
 	// The hash should be the 32-byte hash of the data to be signed.
@@ -29,6 +32,14 @@ type Signer interface {
 	SignTypedData(d *eip712.Domain, data eip712.TypedData) ([]byte, error)
 }
 
+// TxSigner is implemented by signers that sign legacy and EIP-1559 transactions as a whole instead of signing
+// their hashes, such as signing services that do not sign arbitrary hashes. When the Signer implements TxSigner,
+// it is used for signing L1 transactions.
+type TxSigner interface {
+	// SignTx signs the transaction for the specified chain and returns the signed transaction.
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
 // BaseSigner represents basis implementation of Signer interface.
 type BaseSigner struct {
 	pk      *ecdsa.PrivateKey
@@ -112,21 +123,7 @@ func (s *BaseSigner) PrivateKey() *ecdsa.PrivateKey {
 }
 
 func (s *BaseSigner) SignTypedData(domain *eip712.Domain, data eip712.TypedData) ([]byte, error) {
-	// compile TypedData structure
-	eip712Msg, err := data.EIP712Message()
-	if err != nil {
-		return nil, err
-	}
-	typedData := apitypes.TypedData{
-		Types: apitypes.Types{
-			data.EIP712Type():   data.EIP712Types(),
-			domain.EIP712Type(): domain.EIP712Types(),
-		},
-		PrimaryType: data.EIP712Type(),
-		Domain:      domain.EIP712Domain(),
-		Message:     eip712Msg,
-	}
-	hash, err := s.HashTypedData(typedData)
+	hash, err := eip712.HashTypedData(domain, data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get hash of typed data: %w", err)
 	}
@@ -0,0 +1,429 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	"github.com/zksync-sdk/zksync2-go/contracts/erc20"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1messenger"
+	"github.com/zksync-sdk/zksync2-go/contracts/l2bridge"
+	"github.com/zksync-sdk/zksync2-go/contracts/l2wethbridge"
+	"github.com/zksync-sdk/zksync2-go/contracts/nonceholder"
+	"github.com/zksync-sdk/zksync2-go/eip712"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+)
+
+// PayloadSigner signs the payload using the secret of the smart account and returns the signature
+// that is validated by the account contract. The payload is typically the EIP-712 digest of the transaction,
+// the EIP-191 hash of the message or the EIP-712 hash of the typed data.
+type PayloadSigner func(ctx context.Context, payload []byte, secret interface{}, client *clients.Client) ([]byte, error)
+
+// TransactionBuilder populates the unset fields of the transaction sent from the smart account.
+// The sender of the transaction is always set before the builder is invoked.
+type TransactionBuilder func(ctx context.Context, tx *zkTypes.Transaction712, secret interface{}, client *clients.Client) error
+
+// SmartAccount is an account abstraction wallet bound to the address of the account contract. Unlike WalletL2,
+// it does not sign transactions with an EOA private key. Instead, the transactions are populated using
+// the TransactionBuilder and signed using the PayloadSigner, whose result is set as the custom signature
+// of the EIP-712 transaction. The secret can be of any type the signer and builder expect, allowing
+// for flexibility when working with different account implementations.
+type SmartAccount struct {
+	address common.Address
+	secret  interface{}
+	client  *clients.Client
+
+	payloadSigner      PayloadSigner
+	transactionBuilder TransactionBuilder
+}
+
+var _ AdapterL2 = (*SmartAccount)(nil)
+
+// NewSmartAccount creates a new instance of SmartAccount for the account contract at the specified address.
+// The payloadSigner defaults to SignPayloadWithECDSA, and the transactionBuilder defaults to
+// PopulateTransactionECDSA. The client can be nil, in which case only fully populated transactions can be signed.
+func NewSmartAccount(address common.Address, secret interface{}, payloadSigner PayloadSigner,
+	transactionBuilder TransactionBuilder, client *clients.Client) *SmartAccount {
+	if payloadSigner == nil {
+		payloadSigner = SignPayloadWithECDSA
+	}
+	if transactionBuilder == nil {
+		transactionBuilder = PopulateTransactionECDSA
+	}
+	return &SmartAccount{
+		address:            address,
+		secret:             secret,
+		client:             client,
+		payloadSigner:      payloadSigner,
+		transactionBuilder: transactionBuilder,
+	}
+}
+
+// NewECDSASmartAccount creates a new instance of SmartAccount for the account contract that validates
+// the ECDSA signature of its owner.
+func NewECDSASmartAccount(address common.Address, signer Signer, client *clients.Client) *SmartAccount {
+	return NewSmartAccount(address, signer, SignPayloadWithECDSA, PopulateTransactionECDSA, client)
+}
+
+// NewMultisigECDSASmartAccount creates a new instance of SmartAccount for the multisig account contract
+// that validates the concatenated ECDSA signatures of its owners, ordered by the owner address.
+// The threshold is the number of signatures required by the account.
+func NewMultisigECDSASmartAccount(address common.Address, signers []Signer, threshold int, client *clients.Client) *SmartAccount {
+	return NewSmartAccount(
+		address,
+		&MultisigECDSASecret{Signers: signers, Threshold: threshold},
+		SignPayloadWithMultipleECDSA,
+		PopulateTransactionMultipleECDSA,
+		client)
+}
+
+// Connect returns a new instance of SmartAccount with the same address, secret, signer and builder,
+// which uses the provided client.
+func (a *SmartAccount) Connect(client *clients.Client) *SmartAccount {
+	return NewSmartAccount(a.address, a.secret, a.payloadSigner, a.transactionBuilder, client)
+}
+
+// Address returns the address of the account contract.
+func (a *SmartAccount) Address() common.Address {
+	return a.address
+}
+
+// Signer returns the signer of the account if the secret is a Signer, as for the accounts created using
+// NewECDSASmartAccount, otherwise nil.
+func (a *SmartAccount) Signer() Signer {
+	signer, _ := a.secret.(Signer)
+	return signer
+}
+
+// Balance returns the balance of the specified token that can be either ETH or any ERC20 token.
+// The block number can be nil, in which case the balance is taken from the latest known block.
+func (a *SmartAccount) Balance(ctx context.Context, token common.Address, at *big.Int) (*big.Int, error) {
+	if err := a.ensureClient(); err != nil {
+		return nil, err
+	}
+	token, err := clients.ResolveL2Token(ensureContext(ctx), *a.client, token)
+	if err != nil {
+		return nil, err
+	}
+	if token == utils.EthAddress {
+		return (*a.client).BalanceAt(ensureContext(ctx), a.address, at)
+	}
+	erc20Token, err := erc20.NewIERC20(token, *a.client)
+	if err != nil {
+		return nil, err
+	}
+	return erc20Token.BalanceOf(&bind.CallOpts{
+		From:        a.address,
+		BlockNumber: at,
+		Context:     ensureContext(ctx),
+	}, a.address)
+}
+
+// AllBalances returns all balances for confirmed tokens given by an account address.
+func (a *SmartAccount) AllBalances(ctx context.Context) (map[common.Address]*big.Int, error) {
+	if err := a.ensureClient(); err != nil {
+		return nil, err
+	}
+	return (*a.client).AllAccountBalances(ensureContext(ctx), a.address)
+}
+
+// L2BridgeContracts returns L2 bridge contracts.
+func (a *SmartAccount) L2BridgeContracts(ctx context.Context) (*zkTypes.L2BridgeContracts, error) {
+	if err := a.ensureClient(); err != nil {
+		return nil, err
+	}
+	bridgeContracts, err := (*a.client).BridgeContracts(ensureContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to getBridgeContracts: %w", err)
+	}
+	defaultL2Bridge, err := l2bridge.NewIL2Bridge(bridgeContracts.L2Erc20DefaultBridge, *a.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IL2Bridge: %w", err)
+	}
+	var wethL2Bridge *l2wethbridge.IL2WethBridge
+	if bridgeContracts.L2WethBridge != (common.Address{}) {
+		if wethL2Bridge, err = l2wethbridge.NewIL2WethBridge(bridgeContracts.L2WethBridge, *a.client); err != nil {
+			return nil, fmt.Errorf("failed to load IL2WethBridge: %w", err)
+		}
+	}
+	return &zkTypes.L2BridgeContracts{Erc20: defaultL2Bridge, Weth: wethL2Bridge}, nil
+}
+
+// Nonce returns the account nonce of the account. The block number can be nil, in which case the nonce
+// is taken from the latest known block.
+func (a *SmartAccount) Nonce(ctx context.Context, blockNumber *big.Int) (uint64, error) {
+	if err := a.ensureClient(); err != nil {
+		return 0, err
+	}
+	return (*a.client).NonceAt(ensureContext(ctx), a.address, blockNumber)
+}
+
+// DeploymentNonce returns the deployment nonce of the account.
+func (a *SmartAccount) DeploymentNonce(opts *CallOpts) (*big.Int, error) {
+	if err := a.ensureClient(); err != nil {
+		return nil, err
+	}
+	nonceHolder, err := nonceholder.NewINonceHolder(utils.NonceHolderAddress, *a.client)
+	if err != nil {
+		return nil, err
+	}
+	return nonceHolder.GetDeploymentNonce(ensureCallOpts(opts).ToCallOpts(a.address), a.address)
+}
+
+// PopulateTransaction populates the transaction tx using the provided TransactionBuilder.
+func (a *SmartAccount) PopulateTransaction(ctx context.Context, tx Transaction) (*zkTypes.Transaction712, error) {
+	preparedTx := tx.ToTransaction712(a.address)
+	if err := a.transactionBuilder(ensureContext(ctx), preparedTx, a.secret, a.client); err != nil {
+		return nil, fmt.Errorf("failed to populate transaction: %w", err)
+	}
+	return preparedTx, nil
+}
+
+// SignTransaction returns a signed transaction that is ready to be broadcast to the network.
+// The transaction is populated using the TransactionBuilder, and its EIP-712 digest is signed using
+// the PayloadSigner. The resulting signature is set as the custom signature of the transaction.
+func (a *SmartAccount) SignTransaction(tx *zkTypes.Transaction712) ([]byte, error) {
+	return a.signTransaction(context.Background(), tx)
+}
+
+func (a *SmartAccount) signTransaction(ctx context.Context, tx *zkTypes.Transaction712) ([]byte, error) {
+	preparedTx := *tx
+	preparedTx.From = &a.address
+	if err := a.transactionBuilder(ensureContext(ctx), &preparedTx, a.secret, a.client); err != nil {
+		return nil, fmt.Errorf("failed to populate transaction: %w", err)
+	}
+	digest, err := preparedTx.SignedDigest()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := a.payloadSigner(ensureContext(ctx), digest.Bytes(), a.secret, a.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	meta := zkTypes.Eip712Meta{}
+	if preparedTx.Meta != nil {
+		meta = *preparedTx.Meta
+	}
+	meta.CustomSignature = signature
+	preparedTx.Meta = &meta
+	return preparedTx.RLPValues(nil)
+}
+
+// SendTransaction injects a transaction into the pending pool for execution.
+// Any unset transaction fields are prepared using the TransactionBuilder.
+func (a *SmartAccount) SendTransaction(ctx context.Context, tx *Transaction) (common.Hash, error) {
+	if err := a.ensureClient(); err != nil {
+		return common.Hash{}, err
+	}
+	preparedTx, err := a.PopulateTransaction(ensureContext(ctx), *tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	rawTx, err := a.signTransaction(ensureContext(ctx), preparedTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return (*a.client).SendRawTransaction(ensureContext(ctx), rawTx)
+}
+
+// SignMessage signs a message using the PayloadSigner. The message is hashed according to EIP-191,
+// so the signature can be validated by the EIP-1271 isValidSignature method of the account.
+func (a *SmartAccount) SignMessage(ctx context.Context, msg []byte) ([]byte, error) {
+	return a.payloadSigner(ensureContext(ctx), accounts.TextHash(msg), a.secret, a.client)
+}
+
+// SignTypedData signs typed data using the PayloadSigner. The typed data is hashed according to EIP-712,
+// so the signature can be validated by the EIP-1271 isValidSignature method of the account.
+func (a *SmartAccount) SignTypedData(ctx context.Context, domain *eip712.Domain, data eip712.TypedData) ([]byte, error) {
+	hash, err := eip712.HashTypedData(domain, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hash of typed data: %w", err)
+	}
+	return a.payloadSigner(ensureContext(ctx), hash, a.secret, a.client)
+}
+
+// Withdraw initiates the withdrawal process which withdraws ETH or any ERC20 token
+// from the associated account on L2 network to the target account on L1 network.
+// The returned handle does not embed the withdrawal transaction, see WithdrawalHandle.
+func (a *SmartAccount) Withdraw(auth *TransactOpts, tx WithdrawalTransaction) (*WithdrawalHandle, error) {
+	if err := a.ensureClient(); err != nil {
+		return nil, err
+	}
+	opts := ensureTransactOpts(auth)
+	var err error
+	tx.Token, err = clients.ResolveL2Token(opts.Context, *a.client, tx.Token)
+	if err != nil {
+		return nil, err
+	}
+	var defaultL2Bridge *common.Address
+	if tx.Token != utils.EthAddress && tx.BridgeAddress == nil {
+		bridgeContracts, err := (*a.client).BridgeContracts(opts.Context)
+		if err != nil {
+			return nil, fmt.Errorf("failed to getBridgeContracts: %w", err)
+		}
+		defaultL2Bridge = &bridgeContracts.L2Erc20DefaultBridge
+		isWeth, err := utils.IsL2WethToken(opts.Context, *a.client, bridgeContracts.L2WethBridge, tx.Token)
+		if err != nil {
+			return nil, err
+		}
+		if isWeth {
+			defaultL2Bridge = &bridgeContracts.L2WethBridge
+		}
+	}
+	callMsg, err := tx.ToWithdrawalCallMsg(a.address, opts).ToCallMsg(defaultL2Bridge)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := a.sendCallMsg(opts, callMsg.To, callMsg.Value, callMsg.Data, tx.PaymasterParams)
+	if err != nil {
+		return nil, err
+	}
+	return newWithdrawalHandle(hash, 0, a.client, nil), nil
+}
+
+// SendMessageToL1 sends the arbitrary message from the account to L1 network via L1Messenger.sendToL1.
+func (a *SmartAccount) SendMessageToL1(auth *TransactOpts, message []byte) (common.Hash, error) {
+	if err := a.ensureClient(); err != nil {
+		return common.Hash{}, err
+	}
+	messengerAbi, err := l1messenger.IL1MessengerMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to load IL1Messenger: %w", err)
+	}
+	data, err := messengerAbi.Pack("sendToL1", message)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to pack sendToL1 function: %w", err)
+	}
+	return a.sendCallMsg(ensureTransactOpts(auth), &utils.L1MessengerAddress, big.NewInt(0), data, nil)
+}
+
+// L2MessageProof returns the proof of inclusion of the message sent by the transaction via L1Messenger.sendToL1.
+func (a *SmartAccount) L2MessageProof(ctx context.Context, txHash common.Hash, index int) (*L2MessageProof, error) {
+	if err := a.ensureClient(); err != nil {
+		return nil, err
+	}
+	return l2MessageProof(ensureContext(ctx), a.client, txHash, index)
+}
+
+// EstimateGasWithdraw estimates the amount of gas required for a withdrawal transaction.
+func (a *SmartAccount) EstimateGasWithdraw(ctx context.Context, msg WithdrawalCallMsg) (uint64, error) {
+	if err := a.ensureClient(); err != nil {
+		return 0, err
+	}
+	return (*a.client).EstimateGasWithdraw(ensureContext(ctx), msg.ToWithdrawalCallMsg(a.address))
+}
+
+// Transfer moves the ETH or any ERC20 token from the associated account to the target account.
+func (a *SmartAccount) Transfer(auth *TransactOpts, tx TransferTransaction) (common.Hash, error) {
+	if err := a.ensureClient(); err != nil {
+		return common.Hash{}, err
+	}
+	opts := ensureTransactOpts(auth)
+	transferMsg := tx.ToTransferCallMsg(a.address, opts)
+	callMsg, err := transferMsg.ToCallMsg()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return a.sendCallMsg(opts, callMsg.To, callMsg.Value, callMsg.Data, tx.PaymasterParams)
+}
+
+// EstimateGasTransfer estimates the amount of gas required for a transfer transaction.
+func (a *SmartAccount) EstimateGasTransfer(ctx context.Context, msg TransferCallMsg) (uint64, error) {
+	if err := a.ensureClient(); err != nil {
+		return 0, err
+	}
+	return (*a.client).EstimateGasTransfer(ensureContext(ctx), msg.ToTransferCallMsg(a.address))
+}
+
+// CallContract executes a message call for EIP-712 transaction sent from the account, which is
+// directly executed in the VM of the node, but never mined into the blockchain.
+func (a *SmartAccount) CallContract(ctx context.Context, msg CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if err := a.ensureClient(); err != nil {
+		return nil, err
+	}
+	return (*a.client).CallContractL2(ensureContext(ctx), msg.ToCallMsg(a.address), blockNumber)
+}
+
+// CancelTransaction cancels the pending transaction by replacing it with a zero-value transfer to
+// the account, which has the same nonce. The fees are bumped by bumpPercent over the fees of
+// the pending transaction, or by DefaultFeeBumpPercent if bumpPercent is 0.
+func (a *SmartAccount) CancelTransaction(ctx context.Context, hash common.Hash, bumpPercent uint64) (common.Hash, error) {
+	if err := a.ensureClient(); err != nil {
+		return common.Hash{}, err
+	}
+	cancellation, err := cancellationTransaction(ensureContext(ctx), *a.client, a.address, hash, bumpPercent)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return a.SendTransaction(ctx, cancellation)
+}
+
+// ReplaceTransaction resubmits the pending transaction with the same nonce and the fees bumped by bumpPercent,
+// or by DefaultFeeBumpPercent if bumpPercent is 0. The meta must be the metadata of the pending transaction,
+// since the network does not return it.
+func (a *SmartAccount) ReplaceTransaction(ctx context.Context, hash common.Hash, meta *zkTypes.Eip712Meta, bumpPercent uint64) (common.Hash, error) {
+	if err := a.ensureClient(); err != nil {
+		return common.Hash{}, err
+	}
+	replacement, err := replacementTransaction(ensureContext(ctx), *a.client, a.address, hash, meta, bumpPercent)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return a.SendTransaction(ctx, replacement)
+}
+
+// IncreaseMinNonce increases the minimal nonce of the account by the value, which cancels all pending transactions
+// whose nonces are lower than the new minimal nonce. The NonceHolder accepts the call only as a system call,
+// so the account contract must forward the calls to the NonceHolder as system calls.
+func (a *SmartAccount) IncreaseMinNonce(auth *TransactOpts, value *big.Int) (common.Hash, error) {
+	return a.callNonceHolder(auth, "increaseMinNonce", value)
+}
+
+// IncrementMinNonceIfEquals increments the minimal nonce of the account if it equals the expected nonce,
+// which cancels the pending transaction with the expected nonce. The NonceHolder accepts the call only
+// as a system call, so the account contract must forward the calls to the NonceHolder as system calls.
+func (a *SmartAccount) IncrementMinNonceIfEquals(auth *TransactOpts, expectedNonce *big.Int) (common.Hash, error) {
+	return a.callNonceHolder(auth, "incrementMinNonceIfEquals", expectedNonce)
+}
+
+func (a *SmartAccount) callNonceHolder(auth *TransactOpts, method string, args ...interface{}) (common.Hash, error) {
+	if err := a.ensureClient(); err != nil {
+		return common.Hash{}, err
+	}
+	nonceHolderAbi, err := nonceholder.INonceHolderMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to load nonceHolderAbi: %w", err)
+	}
+	data, err := nonceHolderAbi.Pack(method, args...)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to pack %s function: %w", method, err)
+	}
+	return a.sendCallMsg(ensureTransactOpts(auth), &utils.NonceHolderAddress, big.NewInt(0), data, nil)
+}
+
+func (a *SmartAccount) sendCallMsg(opts *TransactOpts, to *common.Address, value *big.Int, data []byte,
+	paymasterParams *zkTypes.PaymasterParams) (common.Hash, error) {
+	return a.SendTransaction(opts.Context, &Transaction{
+		To:        to,
+		Data:      hexutil.Bytes(data),
+		Value:     value,
+		Nonce:     opts.Nonce,
+		GasTipCap: opts.GasTipCap,
+		GasFeeCap: opts.GasFeeCap,
+		Gas:       opts.GasLimit,
+		Meta:      &zkTypes.Eip712Meta{PaymasterParams: paymasterParams},
+	})
+}
+
+func (a *SmartAccount) ensureClient() error {
+	if a.client == nil {
+		return errors.New("client must be provided")
+	}
+	return nil
+}
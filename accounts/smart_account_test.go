@@ -0,0 +1,159 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"math/big"
+	"testing"
+)
+
+func newTestSmartAccountTransaction() *zkTypes.Transaction712 {
+	to := common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618")
+	return &zkTypes.Transaction712{
+		Nonce:     big.NewInt(1),
+		GasTipCap: big.NewInt(0),
+		GasFeeCap: big.NewInt(250_000_000),
+		Gas:       big.NewInt(1_000_000),
+		To:        &to,
+		Value:     big.NewInt(1_000),
+		Data:      hexutil.Bytes{},
+		ChainID:   big.NewInt(270),
+		Meta: &zkTypes.Eip712Meta{
+			GasPerPubdata: (*hexutil.Big)(big.NewInt(50_000)),
+		},
+	}
+}
+
+func TestSmartAccountSignTransaction(t *testing.T) {
+	signer, err := NewBaseSignerFromRawPrivateKey(common.Hex2Bytes("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110"), 270)
+	assert.NoError(t, err, "NewBaseSignerFromRawPrivateKey should not return error")
+
+	address := common.HexToAddress("0x0a67078A35745947A37A552174aFe724D8180c25")
+	account := NewECDSASmartAccount(address, signer, nil)
+	assert.Equal(t, address, account.Address(), "Addresses should be the same")
+
+	rawTx, err := account.SignTransaction(newTestSmartAccountTransaction())
+	assert.NoError(t, err, "SignTransaction should not return error")
+
+	decoded, signature, err := zkTypes.DecodeTransaction712(rawTx)
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	assert.Equal(t, address, *decoded.From, "Sender should be the smart account")
+	assert.Equal(t, []byte(decoded.Meta.CustomSignature), signature, "Signature should be the custom signature")
+	assert.True(t, signature[64] == 27 || signature[64] == 28, "Recovery ID should be 27 or 28")
+
+	recovered, err := decoded.RecoverSigner(signature)
+	assert.NoError(t, err, "RecoverSigner should not return error")
+	assert.Equal(t, signer.Address(), recovered, "Signer should be the owner of the smart account")
+}
+
+func TestSmartAccountReplaceTransaction(t *testing.T) {
+	signer, err := NewBaseSignerFromRawPrivateKey(common.Hex2Bytes("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110"), 270)
+	assert.NoError(t, err, "NewBaseSignerFromRawPrivateKey should not return error")
+	address := common.HexToAddress("0x0a67078A35745947A37A552174aFe724D8180c25")
+	_, client := newReplacementTestWallet(t)
+	client.pendingTx.From = address
+	account := NewECDSASmartAccount(address, signer, toClient(client))
+	assert.Equal(t, Signer(signer), account.Signer(), "Signers should be the same")
+
+	_, err = account.ReplaceTransaction(context.Background(), common.Hash{}, nil, 50)
+	assert.NoError(t, err, "ReplaceTransaction should not return error")
+	_, err = account.CancelTransaction(context.Background(), common.Hash{}, 0)
+	assert.NoError(t, err, "CancelTransaction should not return error")
+	assert.Len(t, client.sent, 2, "Two transactions should be sent")
+
+	replacement, _, err := zkTypes.DecodeTransaction712(client.sent[0])
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	assert.Equal(t, address, *replacement.From, "Sender should be the smart account")
+	assert.Equal(t, big.NewInt(7), replacement.Nonce, "Nonce should be the same as pending transaction nonce")
+	assert.Equal(t, client.pendingTx.To, *replacement.To, "Recipient should be the same")
+	assert.Equal(t, big.NewInt(375_000_000), replacement.GasFeeCap, "Fee should be bumped by specified percentage")
+
+	cancellation, _, err := zkTypes.DecodeTransaction712(client.sent[1])
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	assert.Equal(t, address, *cancellation.To, "Recipient should be the smart account")
+	assert.Equal(t, 0, cancellation.Value.Sign(), "Value should be zero")
+}
+
+func TestSmartAccountSignPartialTransactionWithoutClient(t *testing.T) {
+	signer, err := NewBaseSignerFromRawPrivateKey(common.Hex2Bytes("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110"), 270)
+	assert.NoError(t, err, "NewBaseSignerFromRawPrivateKey should not return error")
+	account := NewECDSASmartAccount(common.HexToAddress("0x0a67078A35745947A37A552174aFe724D8180c25"), signer, nil)
+
+	tx := newTestSmartAccountTransaction()
+	tx.Gas = nil
+	_, err = account.SignTransaction(tx)
+	assert.ErrorContains(t, err, "client must be provided", "SignTransaction should return error for partial transaction")
+
+	tx = newTestSmartAccountTransaction()
+	tx.Nonce = nil
+	_, err = account.SignTransaction(tx)
+	assert.ErrorContains(t, err, "client must be provided", "SignTransaction should return error for partial transaction")
+}
+
+func TestSmartAccountCustomPayloadSigner(t *testing.T) {
+	secret := []byte{1, 2, 3}
+	account := NewSmartAccount(
+		common.HexToAddress("0x0a67078A35745947A37A552174aFe724D8180c25"),
+		secret,
+		func(_ context.Context, payload []byte, s interface{}, _ *clients.Client) ([]byte, error) {
+			return append(append([]byte{}, s.([]byte)...), payload...), nil
+		},
+		nil,
+		nil)
+
+	msg := []byte("Hello, zkSync!")
+	signature, err := account.SignMessage(context.Background(), msg)
+	assert.NoError(t, err, "SignMessage should not return error")
+	assert.Equal(t, append(append([]byte{}, secret...), accounts.TextHash(msg)...), signature, "Signature should be produced by the payload signer")
+
+	rawTx, err := account.SignTransaction(newTestSmartAccountTransaction())
+	assert.NoError(t, err, "SignTransaction should not return error")
+	decoded, _, err := zkTypes.DecodeTransaction712(rawTx)
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	digest, err := decoded.SignedDigest()
+	assert.NoError(t, err, "SignedDigest should not return error")
+	assert.Equal(t, hexutil.Bytes(append(append([]byte{}, secret...), digest.Bytes()...)), decoded.Meta.CustomSignature, "Custom signature should be produced by the payload signer")
+}
+
+func TestSignPayloadWithECDSAInvalidSecret(t *testing.T) {
+	_, err := SignPayloadWithECDSA(context.Background(), crypto.Keccak256([]byte("payload")), "secret", nil)
+	assert.Error(t, err, "SignPayloadWithECDSA should return error for invalid secret")
+}
+
+func TestMultisigSmartAccountSignTransaction(t *testing.T) {
+	signers := make([]Signer, 3)
+	for i := range signers {
+		signer, err := NewRandomBaseSigner(270)
+		assert.NoError(t, err, "NewRandomBaseSigner should not return error")
+		signers[i] = signer
+	}
+	account := NewMultisigECDSASmartAccount(common.HexToAddress("0x0a67078A35745947A37A552174aFe724D8180c25"), signers, 2, nil)
+
+	rawTx, err := account.SignTransaction(newTestSmartAccountTransaction())
+	assert.NoError(t, err, "SignTransaction should not return error")
+	decoded, signature, err := zkTypes.DecodeTransaction712(rawTx)
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	assert.Len(t, signature, 130, "Signature should consist of two ECDSA signatures")
+
+	first, err := decoded.RecoverSigner(signature[:65])
+	assert.NoError(t, err, "RecoverSigner should not return error")
+	second, err := decoded.RecoverSigner(signature[65:])
+	assert.NoError(t, err, "RecoverSigner should not return error")
+	assert.True(t, bytes.Compare(first.Bytes(), second.Bytes()) < 0, "Signatures should be ordered by signer address")
+}
+
+func TestSignPayloadWithMultipleECDSANotEnoughSigners(t *testing.T) {
+	signer, err := NewRandomBaseSigner(270)
+	assert.NoError(t, err, "NewRandomBaseSigner should not return error")
+
+	_, err = SignPayloadWithMultipleECDSA(context.Background(), crypto.Keccak256([]byte("payload")),
+		&MultisigECDSASecret{Signers: []Signer{signer}, Threshold: 2}, nil)
+	assert.Error(t, err, "SignPayloadWithMultipleECDSA should return error when there are not enough signers")
+}
@@ -0,0 +1,179 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"math/big"
+	"sort"
+)
+
+// SignPayloadWithECDSA signs the payload using the ECDSA signature scheme.
+// The secret must be a Signer of the account owner.
+func SignPayloadWithECDSA(_ context.Context, payload []byte, secret interface{}, _ *clients.Client) ([]byte, error) {
+	signer, ok := secret.(Signer)
+	if !ok {
+		return nil, errors.New("secret should be of type Signer")
+	}
+	return signECDSA(signer, payload)
+}
+
+// PopulateTransactionECDSA populates the unset fields of the transaction sent from an account that uses
+// the ECDSA signature scheme. The gas limit is estimated with a dummy signature that has the length of
+// the ECDSA signature.
+func PopulateTransactionECDSA(ctx context.Context, tx *zkTypes.Transaction712, _ interface{}, client *clients.Client) error {
+	return populateSmartAccountTransaction(ctx, tx, 1, client)
+}
+
+// signECDSA signs the hash using the signer and returns the signature with the recovery ID of 27 or 28,
+// as expected by the ECDSA recovery of smart contracts.
+func signECDSA(signer Signer, hash []byte) ([]byte, error) {
+	signature, err := signer.SignHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if signature[64] < 27 {
+		signature[64] += 27
+	}
+	return signature, nil
+}
+
+// populateSmartAccountTransaction populates the unset fields of the transaction sent from the smart
+// account. Since the account validates the signature during gas estimation, the estimation is performed
+// with a dummy signature that consists of the specified number of ECDSA signatures.
+func populateSmartAccountTransaction(ctx context.Context, tx *zkTypes.Transaction712, signatures int, client *clients.Client) error {
+	if tx.From == nil {
+		return errors.New("transaction sender must be provided")
+	}
+	var c clients.Client
+	if client != nil {
+		c = *client
+	}
+	// without the client only the fully populated transaction can be used
+	if c == nil && (tx.ChainID == nil || tx.Nonce == nil || tx.GasFeeCap == nil || tx.Gas == nil || tx.Gas.Sign() == 0) {
+		return errors.New("client must be provided")
+	}
+
+	meta := &zkTypes.Eip712Meta{}
+	if tx.Meta != nil {
+		*meta = *tx.Meta
+	}
+	customSignature := meta.CustomSignature
+	if len(customSignature) == 0 {
+		meta.CustomSignature = dummyECDSASignatures(signatures)
+	}
+
+	var gas uint64 = 0
+	if tx.Gas != nil {
+		gas = tx.Gas.Uint64()
+	}
+	preparedTx, err := populateTransaction(ensureContext(ctx), c, *tx.From, Transaction{
+		To:         tx.To,
+		Data:       tx.Data,
+		Value:      tx.Value,
+		Nonce:      tx.Nonce,
+		GasTipCap:  tx.GasTipCap,
+		GasFeeCap:  tx.GasFeeCap,
+		Gas:        gas,
+		AccessList: tx.AccessList,
+		ChainID:    tx.ChainID,
+		Meta:       meta,
+	})
+	if err != nil {
+		return err
+	}
+	preparedTx.Meta.CustomSignature = customSignature
+	*tx = *preparedTx
+	return nil
+}
+
+// dummyECDSASignatures returns the concatenation of the specified number of well-formed
+// ECDSA signatures, which is used for gas estimation.
+func dummyECDSASignatures(count int) hexutil.Bytes {
+	signature := make([]byte, 65)
+	big.NewInt(1).FillBytes(signature[0:32])
+	big.NewInt(1).FillBytes(signature[32:64])
+	signature[64] = 27
+
+	signatures := make([]byte, 0, 65*count)
+	for i := 0; i < count; i++ {
+		signatures = append(signatures, signature...)
+	}
+	return signatures
+}
+
+// MultisigECDSASecret is the secret of the multisig account that validates the ECDSA signatures of its owners.
+type MultisigECDSASecret struct {
+	Signers   []Signer // The signers of the owners that take part in signing.
+	Threshold int      // The number of signatures required by the account. Defaults to the number of signers.
+}
+
+// SignPayloadWithMultipleECDSA signs the payload using the multiple ECDSA signatures. The secret must be
+// of type *MultisigECDSASecret. The signatures of the first Threshold signers, ordered by the signer
+// address, are concatenated in the same order.
+func SignPayloadWithMultipleECDSA(_ context.Context, payload []byte, secret interface{}, _ *clients.Client) ([]byte, error) {
+	multisig, ok := secret.(*MultisigECDSASecret)
+	if !ok {
+		return nil, errors.New("secret should be of type *MultisigECDSASecret")
+	}
+	threshold := multisig.threshold()
+	if threshold == 0 || len(multisig.Signers) < threshold {
+		return nil, fmt.Errorf("at least %d signers are required, got %d", threshold, len(multisig.Signers))
+	}
+
+	signers := make([]Signer, len(multisig.Signers))
+	copy(signers, multisig.Signers)
+	sort.Slice(signers, func(i, j int) bool {
+		return bytes.Compare(signers[i].Address().Bytes(), signers[j].Address().Bytes()) < 0
+	})
+
+	signatures := make(map[common.Address][]byte, threshold)
+	for _, signer := range signers[:threshold] {
+		signature, err := signECDSA(signer, payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign payload with %s: %w", signer.Address(), err)
+		}
+		signatures[signer.Address()] = signature
+	}
+	return concatSignatures(signatures), nil
+}
+
+// PopulateTransactionMultipleECDSA populates the unset fields of the transaction sent from a multisig account
+// that uses the ECDSA signature scheme. The secret must be of type *MultisigECDSASecret. The gas limit is
+// estimated with a dummy signature that has the length of the Threshold concatenated ECDSA signatures.
+func PopulateTransactionMultipleECDSA(ctx context.Context, tx *zkTypes.Transaction712, secret interface{}, client *clients.Client) error {
+	multisig, ok := secret.(*MultisigECDSASecret)
+	if !ok {
+		return errors.New("secret should be of type *MultisigECDSASecret")
+	}
+	return populateSmartAccountTransaction(ctx, tx, multisig.threshold(), client)
+}
+
+func (s *MultisigECDSASecret) threshold() int {
+	if s.Threshold > 0 {
+		return s.Threshold
+	}
+	return len(s.Signers)
+}
+
+// concatSignatures concatenates the signatures ordered by the signer address.
+func concatSignatures(signatures map[common.Address][]byte) []byte {
+	addresses := make([]common.Address, 0, len(signatures))
+	for address := range signatures {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	result := make([]byte, 0, 65*len(addresses))
+	for _, address := range addresses {
+		result = append(result, signatures[address]...)
+	}
+	return result
+}
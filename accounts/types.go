@@ -450,6 +450,16 @@ type WithdrawalTransaction struct {
 	BridgeAddress *common.Address
 }
 
+// CrossChainTransferTransaction is used to transfer the token between two L2 chains that settle on the same
+// L1 network, using MultiChainWallet.TransferCrossChain.
+type CrossChainTransferTransaction struct {
+	FromChainID int64          // The ID of the chain from which the token is withdrawn.
+	ToChainID   int64          // The ID of the chain to which the token is deposited.
+	To          common.Address // The address that will receive the token on the target chain. Defaults to the sender.
+	Token       common.Address // The L1 address of the token to transfer, utils.EthAddress for ETH.
+	Amount      *big.Int       // The amount of the token to transfer.
+}
+
 func (t *WithdrawalTransaction) ToWithdrawalCallMsg(from common.Address, opts *TransactOpts) *clients.WithdrawalCallMsg {
 	return &clients.WithdrawalCallMsg{
 		To:            t.To,
@@ -558,6 +568,14 @@ type DepositTransaction struct {
 	CustomBridgeData []byte // Additional data that can be sent to a bridge.
 
 	ApproveAuth *TransactOpts // Authorization data for the approval token transaction.
+
+	// Whether should the base token approval be performed under the hood. Set this flag to true if you
+	// deposit to the chain whose base token is not ETH and didn't approve the base token for the shared
+	// bridge beforehand. The base token pays for the L2 transaction, so it is approved even if another
+	// token is deposited.
+	ApproveBaseERC20 bool
+
+	ApproveBaseAuth *TransactOpts // Authorization data for the approval base token transaction.
 }
 
 func (t *DepositTransaction) ToRequestExecuteTransaction() *RequestExecuteTransaction {
@@ -604,6 +622,9 @@ func (t *DepositTransaction) PopulateEmptyFields(from common.Address) {
 	if t.ApproveERC20 && t.ApproveAuth == nil {
 		t.ApproveAuth = ensureTransactOpts(t.ApproveAuth)
 	}
+	if t.ApproveBaseERC20 && t.ApproveBaseAuth == nil {
+		t.ApproveBaseAuth = ensureTransactOpts(t.ApproveBaseAuth)
+	}
 }
 
 // DeploymentType represents an enumeration of deployment types.
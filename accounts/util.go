@@ -2,9 +2,15 @@ package accounts
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
 	"math/big"
 )
 
@@ -50,6 +56,9 @@ func newTransactorWithSigner(signer *Signer, chainID *big.Int) (*bind.TransactOp
 			if address != keyAddr {
 				return nil, bind.ErrNotAuthorized
 			}
+			if txSigner, ok := (*signer).(TxSigner); ok {
+				return txSigner.SignTx(tx, chainID)
+			}
 			signature, err := (*signer).SignHash(latestSigner.Hash(tx).Bytes())
 			if err != nil {
 				return nil, err
@@ -58,3 +67,147 @@ func newTransactorWithSigner(signer *Signer, chainID *big.Int) (*bind.TransactOp
 		},
 	}, nil
 }
+
+// populateTransaction prepares the unset fields of the transaction sent from the specified account.
+func populateTransaction(ctx context.Context, client clients.Client, from common.Address, tx Transaction) (*zkTypes.Transaction712, error) {
+	if tx.ChainID == nil {
+		chainID, err := client.ChainID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get chain ID: %w", err)
+		}
+		tx.ChainID = chainID
+	}
+	if tx.Nonce == nil {
+		nonce, err := client.NonceAt(ctx, from, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get nonce: %w", err)
+		}
+		tx.Nonce = new(big.Int).SetUint64(nonce)
+	}
+	if tx.GasFeeCap == nil {
+		gasFeeCap, err := client.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to SuggestGasPrice: %w", err)
+		}
+		tx.GasFeeCap = gasFeeCap
+	}
+	if tx.GasTipCap == nil {
+		tx.GasTipCap = big.NewInt(0)
+	}
+	if tx.Meta == nil {
+		tx.Meta = &zkTypes.Eip712Meta{GasPerPubdata: utils.NewBig(utils.DefaultGasPerPubdataLimit.Int64())}
+	} else if tx.Meta.GasPerPubdata == nil {
+		tx.Meta.GasPerPubdata = utils.NewBig(utils.DefaultGasPerPubdataLimit.Int64())
+	}
+	if tx.Gas == 0 {
+		gas, err := client.EstimateGasL2(ctx, tx.ToCallMsg(from))
+		if err != nil {
+			return nil, fmt.Errorf("failed to EstimateGasL2: %w", err)
+		}
+		tx.Gas = gas
+	}
+	if tx.Data == nil {
+		tx.Data = hexutil.Bytes{}
+	}
+
+	return tx.ToTransaction712(from), nil
+}
+
+// DefaultFeeBumpPercent is the default percentage by which the fees of the replacement transaction are
+// increased over the fees of the replaced transaction.
+const DefaultFeeBumpPercent uint64 = 10
+
+// bumpFee increases the fee by the specified percentage, rounding up. If the percentage is 0,
+// DefaultFeeBumpPercent is used.
+func bumpFee(fee *big.Int, percent uint64) *big.Int {
+	if percent == 0 {
+		percent = DefaultFeeBumpPercent
+	}
+	bumped := new(big.Int).Mul(fee, new(big.Int).SetUint64(100+percent))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+// cancellationTransaction returns the zero-value transfer to the account itself, which replaces the pending
+// transaction sent from the account.
+func cancellationTransaction(ctx context.Context, client clients.Client, from common.Address, hash common.Hash,
+	bumpPercent uint64) (*Transaction, error) {
+	pendingTx, err := pendingTransaction(ctx, client, from, hash)
+	if err != nil {
+		return nil, err
+	}
+	gasFeeCap, gasTipCap, err := replacementFees(ctx, client, pendingTx, bumpPercent)
+	if err != nil {
+		return nil, err
+	}
+	return &Transaction{
+		To:        &from,
+		Value:     big.NewInt(0),
+		Nonce:     new(big.Int).SetUint64(uint64(pendingTx.Nonce)),
+		GasFeeCap: gasFeeCap,
+		GasTipCap: gasTipCap,
+	}, nil
+}
+
+// replacementTransaction returns the transaction with bumped fees, which replaces the pending transaction
+// sent from the account. The meta is the EIP-712 metadata of the pending transaction.
+func replacementTransaction(ctx context.Context, client clients.Client, from common.Address, hash common.Hash,
+	meta *zkTypes.Eip712Meta, bumpPercent uint64) (*Transaction, error) {
+	pendingTx, err := pendingTransaction(ctx, client, from, hash)
+	if err != nil {
+		return nil, err
+	}
+	if pendingTx.To == (common.Address{}) {
+		return nil, errors.New("replacing transaction without recipient is not supported")
+	}
+	gasFeeCap, gasTipCap, err := replacementFees(ctx, client, pendingTx, bumpPercent)
+	if err != nil {
+		return nil, err
+	}
+	to := pendingTx.To
+	return &Transaction{
+		To:        &to,
+		Data:      pendingTx.Data,
+		Value:     pendingTx.Value.ToInt(),
+		Nonce:     new(big.Int).SetUint64(uint64(pendingTx.Nonce)),
+		GasFeeCap: gasFeeCap,
+		GasTipCap: gasTipCap,
+		Gas:       uint64(pendingTx.Gas),
+		Meta:      meta,
+	}, nil
+}
+
+// pendingTransaction returns the pending transaction sent from the account.
+func pendingTransaction(ctx context.Context, client clients.Client, from common.Address, hash common.Hash) (*zkTypes.TransactionResponse, error) {
+	tx, isPending, err := client.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if !isPending {
+		return nil, errors.New("transaction is not pending")
+	}
+	if tx.From != from {
+		return nil, errors.New("transaction is not sent from the associated account")
+	}
+	return tx, nil
+}
+
+// replacementFees returns the fees of the transaction that replaces the pending transaction. The fees are bumped
+// by the specified percentage over the fees of the pending transaction, but are not lower than the current fees.
+func replacementFees(ctx context.Context, client clients.Client, tx *zkTypes.TransactionResponse, bumpPercent uint64) (*big.Int, *big.Int, error) {
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to SuggestGasPrice: %w", err)
+	}
+	gasFeeCap := maxBigInt(bumpFee(tx.MaxFeePerGas.ToInt(), bumpPercent), gasPrice)
+	gasTipCap := bumpFee(tx.MaxPriorityFeePerGas.ToInt(), bumpPercent)
+	return gasFeeCap, gasTipCap, nil
+}
+
+// maxBigInt returns the larger of x or y.
+func maxBigInt(x, y *big.Int) *big.Int {
+	if x.Cmp(y) < 0 {
+		return y
+	}
+	return x
+}
@@ -123,6 +123,50 @@ func (w *Wallet) PendingNonce(ctx context.Context) (uint64, error) {
 	return (*w.clientL2).PendingNonceAt(ctx, w.Address())
 }
 
+// SetNonceManager sets the manager that allocates the nonces of the L2 transactions sent from the account.
+// It returns an error if the L2 adapter is not a WalletL2. See WalletL2.SetNonceManager.
+func (w *Wallet) SetNonceManager(manager NonceManager) error {
+	walletL2, err := w.walletL2()
+	if err != nil {
+		return err
+	}
+	walletL2.SetNonceManager(manager)
+	return nil
+}
+
+// FillNonceGaps fills the gaps reported by the nonce manager with zero-value transfers.
+// It returns an error if the L2 adapter is not a WalletL2. See WalletL2.FillNonceGaps.
+func (w *Wallet) FillNonceGaps(ctx context.Context) ([]common.Hash, error) {
+	walletL2, err := w.walletL2()
+	if err != nil {
+		return nil, err
+	}
+	return walletL2.FillNonceGaps(ctx)
+}
+
+// walletL2 returns the L2 adapter if it is a WalletL2, which manages the nonces of the account.
+func (w *Wallet) walletL2() (*WalletL2, error) {
+	walletL2, ok := w.AdapterL2.(*WalletL2)
+	if !ok {
+		return nil, fmt.Errorf("nonce management is not supported by L2 adapter of type %T", w.AdapterL2)
+	}
+	return walletL2, nil
+}
+
+// Withdraw initiates the withdrawal process which withdraws ETH or any ERC20 token from the associated account
+// on L2 network to the target account on L1 network. Unlike WalletL2.Withdraw, the returned handle can finalize
+// the withdrawal if the wallet is connected to L1 network.
+func (w *Wallet) Withdraw(auth *TransactOpts, tx WithdrawalTransaction) (*WithdrawalHandle, error) {
+	handle, err := w.AdapterL2.Withdraw(auth, tx)
+	if err != nil {
+		return nil, err
+	}
+	if w.AdapterL1 != nil {
+		handle.ConnectL1(w.AdapterL1)
+	}
+	return handle, nil
+}
+
 // Connect returns a new instance of Wallet with the provided client for the L2 network.
 func (w *Wallet) Connect(client *clients.Client) (*Wallet, error) {
 	s := w.Signer()
@@ -1,7 +1,6 @@
 package accounts
 
 import (
-	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"errors"
@@ -14,15 +13,15 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/zksync-sdk/zksync2-go/clients"
+	"github.com/zksync-sdk/zksync2-go/contracts/bridgehub"
 	"github.com/zksync-sdk/zksync2-go/contracts/erc20"
 	"github.com/zksync-sdk/zksync2-go/contracts/l1bridge"
-	"github.com/zksync-sdk/zksync2-go/contracts/l1messenger"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1wethbridge"
 	"github.com/zksync-sdk/zksync2-go/contracts/l2bridge"
 	"github.com/zksync-sdk/zksync2-go/contracts/zksync"
 	zkTypes "github.com/zksync-sdk/zksync2-go/types"
 	"github.com/zksync-sdk/zksync2-go/utils"
 	"math/big"
-	"strings"
 )
 
 // WalletL1 implements the AdapterL1 interface.
@@ -36,6 +35,17 @@ type WalletL1 struct {
 
 	defaultL1BridgeAddress common.Address
 	defaultL1Bridge        *l1bridge.IL1Bridge
+	wethL1Bridge           *l1wethbridge.IL1WethBridge
+
+	bridges *BridgeRegistry
+
+	// The fields below are set only on chains whose base token is not ETH, which are
+	// reached through the Bridgehub and the shared bridge.
+	chainID             *big.Int
+	baseToken           common.Address
+	bridgehubAddress    common.Address
+	bridgehub           *bridgehub.IBridgehub
+	sharedBridgeAddress common.Address
 }
 
 // NewWalletL1 creates an instance of WalletL1 associated with the account provided by the raw private key.
@@ -78,21 +88,86 @@ func NewWalletL1FromSigner(signer *Signer, clientL1 *ethclient.Client, clientL2
 	if err != nil {
 		return nil, fmt.Errorf("failed to load IL1Bridge: %w", err)
 	}
-	chainId, err := clientL1.ChainID(context.Background())
-	auth, err := newTransactorWithSigner(signer, chainId)
+	defaultBridge, err := NewErc20Bridge(bridgeContracts.L1Erc20DefaultBridge, bridgeContracts.L2Erc20DefaultBridge,
+		clientL1, clientL2)
 	if err != nil {
-		return nil, fmt.Errorf("failed to init TransactOpts: %w", err)
+		return nil, err
 	}
-
-	return &WalletL1{
+	bridges := NewBridgeRegistry(defaultBridge)
+	wallet := &WalletL1{
 		clientL1:               clientL1,
 		clientL2:               clientL2,
-		auth:                   auth,
 		mainContractAddress:    mainContractAddress,
 		defaultL1BridgeAddress: bridgeContracts.L1Erc20DefaultBridge,
 		defaultL1Bridge:        iL1Bridge,
+		bridges:                bridges,
 		mainContract:           iZkSync,
-	}, nil
+		baseToken:              utils.EthAddress,
+	}
+	isEthBasedChain, err := (*clientL2).IsEthBasedChain(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if !isEthBasedChain {
+		if err = wallet.connectBridgehub(context.Background(), bridgeContracts); err != nil {
+			return nil, err
+		}
+	}
+	var wethL1Bridge *l1wethbridge.IL1WethBridge
+	if bridgeContracts.L1WethBridge != (common.Address{}) {
+		wethBridge, errWeth := NewWethBridge(bridgeContracts.L1WethBridge, bridgeContracts.L2WethBridge, clientL1, clientL2)
+		if errWeth != nil {
+			return nil, errWeth
+		}
+		bridges.Register(wethBridge, wethBridge.L1WethAddress())
+		wethL1Bridge = wethBridge.l1WethBridge
+	}
+	chainId, err := clientL1.ChainID(context.Background())
+	auth, err := newTransactorWithSigner(signer, chainId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init TransactOpts: %w", err)
+	}
+
+	wallet.auth = auth
+	wallet.wethL1Bridge = wethL1Bridge
+	return wallet, nil
+}
+
+// connectBridgehub loads the Bridgehub and the base token of the chain whose base token is not ETH,
+// and makes the shared bridge the default bridge.
+func (a *WalletL1) connectBridgehub(ctx context.Context, bridgeContracts *zkTypes.BridgeContracts) error {
+	chainID, err := (*a.clientL2).ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	baseToken, err := (*a.clientL2).BaseTokenContractAddress(ctx)
+	if err != nil {
+		return err
+	}
+	bridgehubAddress, err := (*a.clientL2).BridgehubContractAddress(ctx)
+	if err != nil {
+		return err
+	}
+	iBridgehub, err := bridgehub.NewIBridgehub(bridgehubAddress, a.clientL1)
+	if err != nil {
+		return fmt.Errorf("failed to load IBridgehub: %w", err)
+	}
+	sharedBridgeAddress, err := iBridgehub.SharedBridge(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get sharedBridge: %w", err)
+	}
+	sharedBridge, err := NewSharedBridge(sharedBridgeAddress, bridgeContracts.L2SharedBridge, chainID, a.clientL1, a.clientL2)
+	if err != nil {
+		return err
+	}
+	a.bridges.SetDefault(sharedBridge)
+
+	a.chainID = chainID
+	a.baseToken = baseToken
+	a.bridgehubAddress = bridgehubAddress
+	a.bridgehub = iBridgehub
+	a.sharedBridgeAddress = sharedBridgeAddress
+	return nil
 }
 
 func (a *WalletL1) MainContract(_ context.Context) (*zksync.IZkSync, error) {
@@ -100,7 +175,23 @@ func (a *WalletL1) MainContract(_ context.Context) (*zksync.IZkSync, error) {
 }
 
 func (a *WalletL1) L1BridgeContracts(_ context.Context) (*zkTypes.L1BridgeContracts, error) {
-	return &zkTypes.L1BridgeContracts{Erc20: a.defaultL1Bridge}, nil
+	return &zkTypes.L1BridgeContracts{Erc20: a.defaultL1Bridge, Weth: a.wethL1Bridge}, nil
+}
+
+// Bridges returns the registry of the bridges that are used for deposits, withdrawal finalization and claiming
+// of failed deposits. Custom bridges whose ABI differs from the default ERC20 bridge can be registered in it.
+func (a *WalletL1) Bridges() *BridgeRegistry {
+	return a.bridges
+}
+
+// BaseToken returns the L1 address of the base token of the chain, which is utils.EthAddress on ETH-based chains.
+func (a *WalletL1) BaseToken(_ context.Context) (common.Address, error) {
+	return a.baseToken, nil
+}
+
+// IsEthBasedChain returns whether ETH is the base token of the chain.
+func (a *WalletL1) IsEthBasedChain(_ context.Context) (bool, error) {
+	return a.bridgehub == nil, nil
 }
 
 func (a *WalletL1) BalanceL1(opts *CallOpts, token common.Address) (*big.Int, error) {
@@ -140,7 +231,7 @@ func (a *WalletL1) ApproveERC20(auth *TransactOpts, token common.Address, amount
 		return nil, errors.New("ETH token can't be approved. The address of the token does not exist on L1")
 	}
 	if bridgeAddress == (common.Address{}) {
-		bridgeAddress = a.defaultL1BridgeAddress
+		bridgeAddress = a.bridges.Default().L1Address()
 	}
 
 	erc20Contract, err := erc20.NewIERC20(token, a.clientL1)
@@ -158,6 +249,9 @@ func (a *WalletL1) BaseCost(opts *CallOpts, gasLimit, gasPerPubdataByte, gasPric
 			return nil, err
 		}
 	}
+	if a.bridgehub != nil {
+		return a.bridgehub.L2TransactionBaseCost(callOpts, a.chainID, gasPrice, gasLimit, gasPerPubdataByte)
+	}
 	return a.mainContract.L2TransactionBaseCost(callOpts,
 		gasPrice,
 		gasLimit,
@@ -165,14 +259,18 @@ func (a *WalletL1) BaseCost(opts *CallOpts, gasLimit, gasPerPubdataByte, gasPric
 	)
 }
 
-func (a *WalletL1) Deposit(auth *TransactOpts, tx DepositTransaction) (*types.Transaction, error) {
+func (a *WalletL1) Deposit(auth *TransactOpts, tx DepositTransaction) (*DepositHandle, error) {
+	if a.bridgehub != nil {
+		return a.depositViaBridgehub(ensureTransactOpts(auth), tx)
+	}
 	opts, depositTx, err := a.prepareDepositTx(*ensureTransactOpts(auth), tx)
 	if err != nil {
 		return nil, err
 	}
 
+	var l1Tx *types.Transaction
 	if depositTx.Token == utils.EthAddress {
-		return a.depositETH(opts, depositTx)
+		l1Tx, err = a.depositETH(opts, depositTx)
 	} else {
 		if depositTx.ApproveERC20 {
 			errApprove := a.approveERC20(opts, depositTx)
@@ -180,11 +278,28 @@ func (a *WalletL1) Deposit(auth *TransactOpts, tx DepositTransaction) (*types.Tr
 				return nil, errApprove
 			}
 		}
-		return a.depositERC20(opts, depositTx)
+		l1Tx, err = a.depositERC20(opts, depositTx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	handle := NewDepositHandle(l1Tx, depositTx.Token, a.clientL1, a.clientL2, a)
+	// The failed deposit of ETH, which is the base token of the chain, is refunded on L2. The WETH bridge
+	// does not support claiming failed deposits either, the ETH is refunded on L2 instead.
+	handle.refundedOnL2 = depositTx.Token == utils.EthAddress
+	if depositTx.BridgeAddress != nil {
+		bridge, _ := a.bridges.ByL1Address(*depositTx.BridgeAddress)
+		if _, ok := bridge.(*WethBridge); ok {
+			handle.refundedOnL2 = true
+		}
 	}
+	return handle, nil
 }
 
 func (a *WalletL1) EstimateGasDeposit(ctx context.Context, msg DepositCallMsg) (uint64, error) {
+	if a.bridgehub != nil {
+		return a.estimateGasDepositViaBridgehub(ctx, msg)
+	}
 	auth, prepareDepositTx, err := a.prepareDepositTx(msg.ToTransactOpts(), msg.ToDepositTransaction())
 	if err != nil {
 		return 0, err
@@ -199,46 +314,39 @@ func (a *WalletL1) EstimateGasDeposit(ctx context.Context, msg DepositCallMsg) (
 }
 
 func (a *WalletL1) FullRequiredDepositFee(ctx context.Context, msg DepositCallMsg) (*FullDepositFee, error) {
+	if a.bridgehub != nil {
+		return a.fullRequiredDepositFeeViaBridgehub(ctx, msg)
+	}
 	// It is assumed that the L2 fee for the transaction does not depend on its value.
 	dummyAmount := big.NewInt(1)
 	msg.PopulateEmptyFields(a.auth.From)
 
-	if msg.BridgeAddress != nil {
-		if msg.Token == utils.EthAddress {
+	if msg.Token == utils.EthAddress {
+		if msg.BridgeAddress != nil {
 			return nil, errors.New("ETH token can not be deposited with custom bridge")
 		}
-		bridge, err := l1bridge.NewIL1Bridge(*msg.BridgeAddress, a.clientL1)
+		gas, err := a.EstimateDefaultBridgeDepositL2Gas(ensureContext(ctx), msg.Token, dummyAmount, msg.To,
+			a.auth.From, msg.GasPerPubdataByte)
 		if err != nil {
-			return nil, fmt.Errorf("failed to load custom bridge: %w", err)
+			return nil, err
 		}
-		if msg.CustomBridgeData == nil {
-			msg.CustomBridgeData, err = utils.Erc20DefaultBridgeData(msg.Token, a.clientL1)
-			if err != nil {
-				return nil, err
-			}
+		msg.L2GasLimit = new(big.Int).SetUint64(gas)
+	} else {
+		bridge, err := a.depositBridge(ensureContext(ctx), msg.Token, msg.BridgeAddress)
+		if err != nil {
+			return nil, err
 		}
-
 		if msg.L2GasLimit == nil {
-			l2Address, errBridge := bridge.L2Bridge(nil)
-			if errBridge != nil {
-				return nil, errBridge
-			}
-
-			gas, errGas := a.EstimateCustomBridgeDepositL2Gas(ensureContext(ctx), *msg.BridgeAddress, l2Address,
-				msg.Token, dummyAmount, msg.To, msg.CustomBridgeData, a.auth.From, msg.GasPerPubdataByte)
+			tx := msg.ToDepositTransaction()
+			tx.Amount = dummyAmount
+			gas, errGas := bridge.EstimateDepositL2Gas(ensureContext(ctx), &tx, a.auth.From)
 			if errGas != nil {
 				return nil, errGas
 			}
 			msg.L2GasLimit = new(big.Int).SetUint64(gas)
 		}
-
-	} else {
-		gas, err := a.EstimateDefaultBridgeDepositL2Gas(ensureContext(ctx), msg.Token, dummyAmount, msg.To,
-			a.auth.From, msg.GasPerPubdataByte)
-		if err != nil {
-			return nil, err
-		}
-		msg.L2GasLimit = new(big.Int).SetUint64(gas)
+		l1BridgeAddress := bridge.L1Address()
+		msg.BridgeAddress = &l1BridgeAddress
 	}
 
 	if err := a.insertGasPriceInDepositMsg(ensureContext(ctx), &msg); err != nil {
@@ -279,17 +387,7 @@ func (a *WalletL1) FullRequiredDepositFee(ctx context.Context, msg DepositCallMs
 	// value for the L2 commission subtracted.
 	amountForEstimation := big.NewInt(dummyAmount.Int64())
 	if msg.Token != utils.EthAddress {
-		var bridgeAddress common.Address
-		if msg.BridgeAddress == nil {
-			contracts, errContracts := (*a.clientL2).BridgeContracts(ensureContext(ctx))
-			if errContracts != nil {
-				return nil, errContracts
-			}
-			bridgeAddress = contracts.L1Erc20DefaultBridge
-		} else {
-			bridgeAddress = *msg.BridgeAddress
-		}
-		allowance, errAllowance := a.AllowanceL1(nil, msg.Token, bridgeAddress)
+		allowance, errAllowance := a.AllowanceL1(nil, msg.Token, *msg.BridgeAddress)
 		if errAllowance != nil {
 			return nil, errAllowance
 		}
@@ -349,76 +447,42 @@ func (a *WalletL1) FinalizeWithdraw(auth *TransactOpts, withdrawalHash common.Ha
 		opts = auth.ToTransactOpts(a.auth.From, a.auth.Signer)
 	}
 
-	log, l1BatchTxId, err := a.getWithdrawalLog(opts.Context, withdrawalHash, index)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get WithdrawalLog: %w", err)
-	}
-	if l1BatchTxId == nil {
-		return nil, errors.New("empty l1BatchTxIndex")
-	}
-	l2ToL1LogIndex, _, err := a.getWithdrawalL2ToL1Log(opts.Context, withdrawalHash, index)
+	proof, err := l2MessageProof(opts.Context, a.clientL2, withdrawalHash, index)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get WithdrawalL2ToL1Log: %w", err)
+		return nil, fmt.Errorf("failed to get withdrawal proof: %w", err)
 	}
-	if len(log.Topics) < 2 {
-		return nil, errors.New("not enough Topics count")
-	}
-	sender := common.BytesToAddress(log.Topics[1].Bytes()[12:])
-	proof, err := (*a.clientL2).LogProof(opts.Context, withdrawalHash, l2ToL1LogIndex)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get L2ToL1LogProof: %w", err)
+	params := &FinalizeWithdrawalParams{
+		L2BatchNumber:     proof.L2BatchNumber,
+		L2MessageIndex:    proof.L2MessageIndex,
+		L2TxNumberInBatch: proof.L2TxNumberInBatch,
+		Message:           proof.Message,
+		Proof:             proof.Proof,
 	}
 
-	l1MessengerAbi, err := abi.JSON(strings.NewReader(l1messenger.IL1MessengerMetaData.ABI))
-	if err != nil {
-		return nil, fmt.Errorf("failed to load l1MessengerAbi: %w", err)
+	// base token on chains whose base token is not ETH
+	if proof.Sender == utils.L2BaseTokenAddress && a.bridgehub != nil {
+		return a.bridges.Default().FinalizeWithdrawal(opts, params)
 	}
-	ev, err := l1MessengerAbi.EventByID(log.Topics[0])
-	if err != nil {
-		return nil, fmt.Errorf("failed to get EventByID: %w", err)
-	}
-	dl, err := l1MessengerAbi.Unpack(ev.Name, log.Data)
-	if err != nil {
-		return nil, fmt.Errorf("failed to Unpack log data: %w", err)
-	}
-	message := dl[0].([]byte)
-
-	proof32 := make([][32]byte, len(proof.Proof))
-	for i, pr := range proof.Proof {
-		proof32[i] = pr
-	}
-
 	// ETH token
-	if sender == utils.L2EthTokenAddress {
+	if proof.Sender == utils.L2EthTokenAddress {
+		// WETH is withdrawn as ETH sent to the L1 WETH bridge, which wraps it for the L1 receiver
+		if bridge, ok := a.wethBridgeByEthWithdrawal(proof.Message); ok {
+			return bridge.FinalizeWithdrawal(opts, params)
+		}
 		return a.mainContract.FinalizeEthWithdrawal(opts,
-			log.L1BatchNumber.ToInt(),
-			big.NewInt(int64(proof.Id)),
-			uint16(l1BatchTxId.Uint64()),
-			message,
-			proof32,
+			params.L2BatchNumber,
+			params.L2MessageIndex,
+			params.L2TxNumberInBatch,
+			params.Message,
+			params.Proof,
 		)
 	}
 	// other tokens
-	l2Bridge, err := l2bridge.NewIL2Bridge(sender, *a.clientL2)
+	bridge, err := a.withdrawalBridge(opts.Context, proof)
 	if err != nil {
-		return nil, fmt.Errorf("failed to init l2Bridge: %w", err)
-	}
-	l1BridgeAddress, err := l2Bridge.L1Bridge(&bind.CallOpts{Context: opts.Context})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get l1BridgeAddress: %w", err)
-	}
-	l1Bridge, err := l1bridge.NewIL1Bridge(l1BridgeAddress, a.clientL1)
-	if err != nil {
-		return nil, fmt.Errorf("failed to init l1Bridge: %w", err)
+		return nil, err
 	}
-
-	return l1Bridge.FinalizeWithdrawal(opts,
-		log.L1BatchNumber.ToInt(),
-		big.NewInt(int64(proof.Id)),
-		uint16(l1BatchTxId.Uint64()),
-		message,
-		proof32,
-	)
+	return bridge.FinalizeWithdrawal(opts, params)
 }
 
 func (a *WalletL1) IsWithdrawFinalized(opts *CallOpts, withdrawalHash common.Hash, index int) (bool, error) {
@@ -426,40 +490,56 @@ func (a *WalletL1) IsWithdrawFinalized(opts *CallOpts, withdrawalHash common.Has
 	if a.clientL1 == nil {
 		return false, errors.New("ethereum provider is not initialized")
 	}
-	log, _, err := a.getWithdrawalLog(callOpts.Context, withdrawalHash, index)
-	if err != nil {
-		return false, fmt.Errorf("failed to get WithdrawalLog: %w", err)
-	}
-	l2ToL1LogIndex, _, err := a.getWithdrawalL2ToL1Log(callOpts.Context, withdrawalHash, index)
+	proof, err := l2MessageProof(callOpts.Context, a.clientL2, withdrawalHash, index)
 	if err != nil {
-		return false, fmt.Errorf("failed to get WithdrawalL2ToL1Log: %w", err)
+		return false, fmt.Errorf("failed to get withdrawal proof: %w", err)
 	}
-	if len(log.Topics) < 2 {
-		return false, errors.New("not enough Topics count")
-	}
-	sender := common.BytesToAddress(log.Topics[1].Bytes()[12:])
-	proof, err := (*a.clientL2).LogProof(callOpts.Context, withdrawalHash, l2ToL1LogIndex)
-	if err != nil {
-		return false, fmt.Errorf("failed to get L2ToL1LogProof: %w", err)
+	// base token on chains whose base token is not ETH
+	if proof.Sender == utils.L2BaseTokenAddress && a.bridgehub != nil {
+		return a.bridges.Default().IsWithdrawalFinalized(callOpts, proof.L2BatchNumber, proof.L2MessageIndex)
 	}
 	// ETH token
-	if sender == utils.L2EthTokenAddress {
-		return a.mainContract.IsEthWithdrawalFinalized(callOpts, log.L1BatchNumber.ToInt(), big.NewInt(int64(proof.Id)))
+	if proof.Sender == utils.L2EthTokenAddress {
+		if bridge, ok := a.wethBridgeByEthWithdrawal(proof.Message); ok {
+			return bridge.IsWithdrawalFinalized(callOpts, proof.L2BatchNumber, proof.L2MessageIndex)
+		}
+		return a.mainContract.IsEthWithdrawalFinalized(callOpts, proof.L2BatchNumber, proof.L2MessageIndex)
 	}
 	// other tokens
-	l2Bridge, err := l2bridge.NewIL2Bridge(sender, *a.clientL2)
+	bridge, err := a.withdrawalBridge(callOpts.Context, proof)
+	if err != nil {
+		return false, err
+	}
+	return bridge.IsWithdrawalFinalized(callOpts, proof.L2BatchNumber, proof.L2MessageIndex)
+}
+
+func (a *WalletL1) ProveL2MessageInclusion(opts *CallOpts, proof *L2MessageProof) (bool, error) {
+	callOpts := ensureCallOpts(opts).ToCallOpts(a.auth.From)
+	return a.mainContract.ProveL2MessageInclusion(callOpts, proof.L2BatchNumber, proof.L2MessageIndex, proof.L2Message(), proof.Proof)
+}
+
+func (a *WalletL1) VerifyL2MessageProof(opts *CallOpts, txHash common.Hash, index int) (*L2MessageProof, error) {
+	callOpts := ensureCallOpts(opts).ToCallOpts(a.auth.From)
+	proof, err := l2MessageProof(callOpts.Context, a.clientL2, txHash, index)
 	if err != nil {
-		return false, fmt.Errorf("failed to init l2Bridge: %w", err)
+		return nil, err
 	}
-	l1BridgeAddress, err := l2Bridge.L1Bridge(callOpts)
+	_, l2ToL1Log, err := l1MessengerL2ToL1Log(callOpts.Context, a.clientL2, txHash, index)
 	if err != nil {
-		return false, fmt.Errorf("failed to get l1BridgeAddress: %w", err)
+		return nil, err
+	}
+	if err = verifyL2MessageProof(proof, l2ToL1Log); err != nil {
+		return nil, err
 	}
-	l1Bridge, err := l1bridge.NewIL1Bridge(l1BridgeAddress, a.clientL1)
+	root, err := a.mainContract.L2LogsRootHash(callOpts, proof.L2BatchNumber)
 	if err != nil {
-		return false, fmt.Errorf("failed to init l1Bridge: %w", err)
+		return nil, fmt.Errorf("failed to get L2LogsRootHash: %w", err)
+	}
+	if common.Hash(root) != proof.Root {
+		return nil, fmt.Errorf("proof root %s does not match L2 logs root %s of batch %s stored on L1",
+			proof.Root, common.Hash(root), proof.L2BatchNumber)
 	}
-	return l1Bridge.IsWithdrawalFinalized(callOpts, log.L1BatchNumber.ToInt(), big.NewInt(int64(proof.Id)))
+	return proof, nil
 }
 
 func (a *WalletL1) ClaimFailedDeposit(auth *TransactOpts, depositHash common.Hash) (*types.Transaction, error) {
@@ -493,31 +573,10 @@ func (a *WalletL1) ClaimFailedDeposit(auth *TransactOpts, depositHash common.Has
 
 	// Undo the aliasing, since the Mailbox contract set it as for contract address.
 	l1BridgeAddress := utils.UndoL1ToL2Alias(receipt.From)
-	l1Bridge, err := l1bridge.NewIL1Bridge(l1BridgeAddress, a.clientL1)
-	if err != nil {
-		return nil, fmt.Errorf("failed to init l1Bridge: %w", err)
-	}
-	l2Bridge, err := abi.JSON(strings.NewReader(l2bridge.IL2BridgeMetaData.ABI))
+	bridge, err := a.bridgeByL1Address(opts.Context, l1BridgeAddress)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load l2Bridge ABI: %w", err)
-	}
-	calldata, err := l2Bridge.Unpack("finalizeDeposit", tx.Data)
-	if err != nil {
-		return nil, fmt.Errorf("failed to Unpack finalizeDeposit data: %w", err)
-	}
-	if len(calldata) < 3 {
-		return nil, errors.New("unpacked calldata is empty")
-	}
-	b20, ok := calldata[0].([20]byte)
-	if !ok {
-		return nil, errors.New("failed to parse l1Sender from unpacked calldata")
-	}
-	l1Sender := common.BytesToAddress(b20[:])
-	b20, ok = calldata[2].([20]byte)
-	if !ok {
-		return nil, errors.New("failed to parse l1Token from unpacked calldata")
+		return nil, err
 	}
-	l1Token := common.BytesToAddress(b20[:])
 
 	proof, err := (*a.clientL2).LogProof(opts.Context, depositHash, successL2ToL1LogIndex)
 	if err != nil {
@@ -529,16 +588,14 @@ func (a *WalletL1) ClaimFailedDeposit(auth *TransactOpts, depositHash common.Has
 		proof32[i] = pr
 	}
 
-	return l1Bridge.ClaimFailedDeposit(
-		opts.ToTransactOpts(a.auth.From, a.auth.Signer),
-		l1Sender,
-		l1Token,
-		depositHash,
-		receipt.L1BatchNumber.ToInt(),
-		big.NewInt(int64(proof.Id)),
-		uint16(receipt.L1BatchTxIndex.ToInt().Uint64()),
-		proof32,
-	)
+	return bridge.ClaimFailedDeposit(opts.ToTransactOpts(a.auth.From, a.auth.Signer), &ClaimFailedDepositParams{
+		L2TxHash:          depositHash,
+		L2Calldata:        tx.Data,
+		L2BatchNumber:     receipt.L1BatchNumber.ToInt(),
+		L2MessageIndex:    big.NewInt(int64(proof.Id)),
+		L2TxNumberInBatch: uint16(receipt.L1BatchTxIndex.ToInt().Uint64()),
+		Proof:             proof32,
+	})
 }
 
 func (a *WalletL1) RequestExecute(auth *TransactOpts, tx RequestExecuteTransaction) (*types.Transaction, error) {
@@ -546,6 +603,15 @@ func (a *WalletL1) RequestExecute(auth *TransactOpts, tx RequestExecuteTransacti
 	if err != nil {
 		return nil, err
 	}
+	if a.bridgehub != nil {
+		// The value is minted in the base token, which is transferred by the shared bridge.
+		calldata, errCalldata := a.bridgehubRequestExecuteCalldata(requestExecuteTx, opts.Value)
+		if errCalldata != nil {
+			return nil, errCalldata
+		}
+		opts.Value = big.NewInt(0)
+		return a.transactBridgehub(opts, calldata)
+	}
 	return a.mainContract.RequestL2Transaction(
 		opts.ToTransactOpts(a.auth.From, a.auth.Signer),
 		requestExecuteTx.ContractAddress,
@@ -563,6 +629,20 @@ func (a *WalletL1) EstimateGasRequestExecute(ctx context.Context, msg RequestExe
 	if err != nil {
 		return 0, err
 	}
+	if a.bridgehub != nil {
+		calldata, errCalldata := a.bridgehubRequestExecuteCalldata(prepareRequestExecuteTx, opts.Value)
+		if errCalldata != nil {
+			return 0, errCalldata
+		}
+		return a.clientL1.EstimateGas(ensureContext(ctx), ethereum.CallMsg{
+			From:      a.auth.From,
+			To:        &a.bridgehubAddress,
+			GasPrice:  opts.GasPrice,
+			GasFeeCap: opts.GasFeeCap,
+			GasTipCap: opts.GasTipCap,
+			Data:      calldata,
+		})
+	}
 
 	preparedRequestExecuteCallMsg := prepareRequestExecuteTx.ToRequestExecuteCallMsg(opts)
 	callMsg, err := preparedRequestExecuteCallMsg.ToCallMsg(a.auth.From)
@@ -572,6 +652,99 @@ func (a *WalletL1) EstimateGasRequestExecute(ctx context.Context, msg RequestExe
 	return a.clientL1.EstimateGas(ensureContext(ctx), callMsg)
 }
 
+func (a *WalletL1) ReplaceTransactionL1(ctx context.Context, hash common.Hash, bumpPercent uint64) (*types.Transaction, error) {
+	ctx = ensureContext(ctx)
+	tx, isPending, err := a.clientL1.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if !isPending {
+		return nil, errors.New("transaction is not pending")
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction sender: %w", err)
+	}
+	if sender != a.auth.From {
+		return nil, errors.New("transaction is not sent from the associated account")
+	}
+	if a.isPriorityRequest(tx) {
+		// The value of the priority request covers the base cost at the gas price of the original
+		// transaction, so the replacement with bumped fees would underpay it.
+		return nil, errors.New("replacing priority request transaction is not supported")
+	}
+
+	var replacement types.TxData
+	switch tx.Type() {
+	case types.LegacyTxType:
+		gasPrice, errPrice := a.clientL1.SuggestGasPrice(ctx)
+		if errPrice != nil {
+			return nil, fmt.Errorf("failed to SuggestGasPrice: %w", errPrice)
+		}
+		replacement = &types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: maxBigInt(bumpFee(tx.GasPrice(), bumpPercent), gasPrice),
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		}
+	case types.DynamicFeeTxType:
+		gasTipCap, errTip := a.clientL1.SuggestGasTipCap(ctx)
+		if errTip != nil {
+			return nil, fmt.Errorf("failed to SuggestGasTipCap: %w", errTip)
+		}
+		head, errHead := a.clientL1.HeaderByNumber(ctx, nil)
+		if errHead != nil {
+			return nil, fmt.Errorf("failed to get latest header: %w", errHead)
+		}
+		gasTipCap = maxBigInt(bumpFee(tx.GasTipCap(), bumpPercent), gasTipCap)
+		// BaseFee * 3 / 2 + GasTipCap
+		gasFeeCap := new(big.Int).Add(
+			gasTipCap,
+			new(big.Int).Div(new(big.Int).Mul(head.BaseFee, big.NewInt(3)), big.NewInt(2)),
+		)
+		replacement = &types.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      tx.Nonce(),
+			GasTipCap:  gasTipCap,
+			GasFeeCap:  maxBigInt(bumpFee(tx.GasFeeCap(), bumpPercent), gasFeeCap),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		}
+	default:
+		return nil, fmt.Errorf("unsupported transaction type: %d", tx.Type())
+	}
+
+	signedTx, err := a.auth.Signer(a.auth.From, types.NewTx(replacement))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	if err = a.clientL1.SendTransaction(ctx, signedTx); err != nil {
+		return nil, err
+	}
+	return signedTx, nil
+}
+
+// isPriorityRequest checks whether the transaction requests the L2 transaction from L1, either directly
+// through the main contract or the Bridgehub, or as the deposit through the L1 bridge, which pays the base cost.
+func (a *WalletL1) isPriorityRequest(tx *types.Transaction) bool {
+	if tx.To() == nil {
+		return false
+	}
+	to := *tx.To()
+	if to == a.mainContractAddress || (a.bridgehub != nil && to == a.bridgehubAddress) {
+		return true
+	}
+	if _, ok := a.bridges.ByL1Address(to); ok {
+		return tx.Value().Sign() > 0
+	}
+	return false
+}
+
 // EstimateCustomBridgeDepositL2Gas used by EstimateDefaultBridgeDepositL2Gas to estimate L2 gas required for token
 // bridging via a custom ERC20 bridge.
 func (a *WalletL1) EstimateCustomBridgeDepositL2Gas(ctx context.Context, l1BridgeAddress, l2BridgeAddress, token common.Address,
@@ -593,8 +766,8 @@ func (a *WalletL1) EstimateCustomBridgeDepositL2Gas(ctx context.Context, l1Bridg
 	})
 }
 
-// EstimateDefaultBridgeDepositL2Gas returns an estimation of L2 gas required for token bridging via the default ERC20
-// bridge.
+// EstimateDefaultBridgeDepositL2Gas returns an estimation of L2 gas required for token bridging via the bridge
+// registered for the token, which is the default ERC20 bridge unless another bridge is registered in Bridges.
 func (a *WalletL1) EstimateDefaultBridgeDepositL2Gas(ctx context.Context, token common.Address, amount *big.Int,
 	to, from common.Address, gasPerPubdataByte *big.Int) (uint64, error) {
 
@@ -626,25 +799,12 @@ func (a *WalletL1) EstimateDefaultBridgeDepositL2Gas(ctx context.Context, token
 			},
 		})
 	} else {
-		bridgeContracts, err := (*a.clientL2).BridgeContracts(ensureContext(ctx))
-		if err != nil {
-			return 0, err
-		}
-		calldata, err := utils.Erc20DefaultBridgeData(token, a.clientL1)
-		if err != nil {
-			return 0, err
-		}
-
-		return a.EstimateCustomBridgeDepositL2Gas(
-			ensureContext(ctx),
-			bridgeContracts.L1Erc20DefaultBridge,
-			bridgeContracts.L2Erc20DefaultBridge,
-			token,
-			amount,
-			to,
-			calldata,
-			from,
-			gasPerPubdataByte)
+		return a.bridges.ForToken(token).EstimateDepositL2Gas(ensureContext(ctx), &DepositTransaction{
+			To:                to,
+			Token:             token,
+			Amount:            amount,
+			GasPerPubdataByte: gasPerPubdataByte,
+		}, from)
 	}
 }
 
@@ -652,42 +812,30 @@ func (a *WalletL1) prepareDepositTx(auth TransactOpts, tx DepositTransaction) (*
 	opts := ensureTransactOpts(&auth)
 	tx.PopulateEmptyFields(a.auth.From)
 
-	if tx.BridgeAddress != nil {
-		if tx.Token == utils.EthAddress {
+	if tx.Token == utils.EthAddress {
+		if tx.BridgeAddress != nil {
 			return nil, nil, errors.New("ETH token can not be deposited with custom bridge")
 		}
-		bridge, err := l1bridge.NewIL1Bridge(*tx.BridgeAddress, a.clientL1)
+		gas, err := a.EstimateDefaultBridgeDepositL2Gas(opts.Context, tx.Token, tx.Amount, tx.To,
+			a.auth.From, tx.GasPerPubdataByte)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to load custom bridge: %w", err)
+			return nil, nil, err
 		}
-		if tx.CustomBridgeData == nil {
-			tx.CustomBridgeData, err = utils.Erc20DefaultBridgeData(tx.Token, a.clientL1)
-			if err != nil {
-				return nil, nil, err
-			}
+		tx.L2GasLimit = new(big.Int).SetUint64(gas)
+	} else {
+		bridge, err := a.depositBridge(opts.Context, tx.Token, tx.BridgeAddress)
+		if err != nil {
+			return nil, nil, err
 		}
-
 		if tx.L2GasLimit == nil {
-			l2Address, errBridge := bridge.L2Bridge(nil)
-			if errBridge != nil {
-				return nil, nil, errBridge
-			}
-
-			gas, errGas := a.EstimateCustomBridgeDepositL2Gas(opts.Context, *tx.BridgeAddress, l2Address, tx.Token,
-				tx.Amount, tx.To, tx.CustomBridgeData, a.auth.From, tx.GasPerPubdataByte)
+			gas, errGas := bridge.EstimateDepositL2Gas(opts.Context, &tx, a.auth.From)
 			if errGas != nil {
 				return nil, nil, errGas
 			}
 			tx.L2GasLimit = new(big.Int).SetUint64(gas)
 		}
-
-	} else {
-		gas, err := a.EstimateDefaultBridgeDepositL2Gas(opts.Context, tx.Token, tx.Amount, tx.To,
-			a.auth.From, tx.GasPerPubdataByte)
-		if err != nil {
-			return nil, nil, err
-		}
-		tx.L2GasLimit = new(big.Int).SetUint64(gas)
+		l1BridgeAddress := bridge.L1Address()
+		tx.BridgeAddress = &l1BridgeAddress
 	}
 
 	if err := a.insertGasPriceInTransactOpts(&opts); err != nil {
@@ -738,53 +886,41 @@ func (a *WalletL1) depositETH(auth *TransactOpts, tx *DepositTransaction) (*type
 }
 
 func (a *WalletL1) depositERC20(auth *TransactOpts, tx *DepositTransaction) (*types.Transaction, error) {
-	if tx.BridgeAddress != nil {
-		l1Bridge, err := l1bridge.NewIL1Bridge(*tx.BridgeAddress, a.clientL1)
-		if err != nil {
-			return nil, fmt.Errorf("failed to load IL1Bridge: %w", err)
-		}
-		return l1Bridge.Deposit(
-			auth.ToTransactOpts(a.auth.From, a.auth.Signer),
-			tx.To,
-			tx.Token,
-			tx.Amount,
-			tx.L2GasLimit,
-			tx.GasPerPubdataByte,
-			tx.RefundRecipient,
-		)
-	} else {
-		return a.defaultL1Bridge.Deposit(
-			auth.ToTransactOpts(a.auth.From, a.auth.Signer),
-			tx.To,
-			tx.Token,
-			tx.Amount,
-			tx.L2GasLimit,
-			tx.GasPerPubdataByte,
-			tx.RefundRecipient,
-		)
+	bridge, err := a.depositBridge(auth.Context, tx.Token, tx.BridgeAddress)
+	if err != nil {
+		return nil, err
+	}
+	calldata, err := bridge.DepositCalldata(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode deposit calldata: %w", err)
 	}
+	contract := bind.NewBoundContract(bridge.L1Address(), abi.ABI{}, a.clientL1, a.clientL1, a.clientL1)
+	return contract.RawTransact(auth.ToTransactOpts(a.auth.From, a.auth.Signer), calldata)
 }
 
 func (a *WalletL1) approveERC20(auth *TransactOpts, tx *DepositTransaction) error {
-	// We only request the allowance if the current one is not enough.
 	bridge := a.defaultL1BridgeAddress
 	if tx.BridgeAddress != nil {
 		bridge = *tx.BridgeAddress
 	}
+	return a.approveAllowance(auth, tx.ApproveAuth, tx.Token, bridge, tx.Amount)
+}
+
+// approveAllowance approves the amount of the token for the spender and waits for the approval
+// to be mined. We only request the allowance if the current one is not enough.
+func (a *WalletL1) approveAllowance(auth, approveAuth *TransactOpts, token, spender common.Address, amount *big.Int) error {
 	allowance, err := a.AllowanceL1(&CallOpts{
 		Context: auth.Context,
-	}, tx.Token, bridge)
+	}, token, spender)
 	if err != nil {
 		return err
 	}
-	if allowance.Cmp(tx.Amount) < 0 {
-		approveTx, errApprove := a.ApproveERC20(
-			tx.ApproveAuth, tx.Token, tx.Amount, bridge,
-		)
+	if allowance.Cmp(amount) < 0 {
+		approveTx, errApprove := a.ApproveERC20(approveAuth, token, amount, spender)
 		if errApprove != nil {
 			return errApprove
 		}
-		_, err = bind.WaitMined(ensureContext(tx.ApproveAuth.Context), a.clientL1, approveTx)
+		_, err = bind.WaitMined(ensureContext(approveAuth.Context), a.clientL1, approveTx)
 		if err != nil {
 			return err
 		}
@@ -793,16 +929,19 @@ func (a *WalletL1) approveERC20(auth *TransactOpts, tx *DepositTransaction) erro
 }
 
 func (a *WalletL1) estimateDepositERC20(ctx context.Context, msg DepositCallMsg) (uint64, error) {
-	var bridge common.Address
-	if msg.BridgeAddress == nil {
-		bridge = a.defaultL1BridgeAddress
-	} else {
-		bridge = *msg.BridgeAddress
+	bridge, err := a.depositBridge(ensureContext(ctx), msg.Token, msg.BridgeAddress)
+	if err != nil {
+		return 0, err
 	}
-	callMsg, err := msg.ToCallMsg(a.auth.From, bridge)
+	callMsg, err := msg.ToCallMsg(a.auth.From, bridge.L1Address())
 	if err != nil {
 		return 0, err
 	}
+	tx := msg.ToDepositTransaction()
+	callMsg.Data, err = bridge.DepositCalldata(&tx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode deposit calldata: %w", err)
+	}
 	return a.clientL1.EstimateGas(ensureContext(ctx), callMsg)
 }
 
@@ -931,51 +1070,81 @@ func (a *WalletL1) insertGasPriceInDepositMsg(ctx context.Context, msg *DepositC
 	return nil
 }
 
-func (a *WalletL1) getWithdrawalLog(ctx context.Context, withdrawalHash common.Hash, index int) (*zkTypes.Log, *big.Int, error) {
-	receipt, err := (*a.clientL2).TransactionReceipt(ctx, withdrawalHash)
+// depositBridge returns the bridge at the provided L1 address, or the bridge registered for the token
+// if the address is not provided.
+func (a *WalletL1) depositBridge(ctx context.Context, token common.Address, bridgeAddress *common.Address) (Bridge, error) {
+	if bridgeAddress == nil {
+		return a.bridges.ForToken(token), nil
+	}
+	return a.bridgeByL1Address(ctx, *bridgeAddress)
+}
+
+// bridgeByL1Address returns the bridge registered at the L1 address. If there is no such bridge,
+// the bridge is assumed to share the ABI of the default ERC20 bridge.
+func (a *WalletL1) bridgeByL1Address(ctx context.Context, address common.Address) (Bridge, error) {
+	if bridge, ok := a.bridges.ByL1Address(address); ok {
+		return bridge, nil
+	}
+	l1Bridge, err := l1bridge.NewIL1Bridge(address, a.clientL1)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to get TransactionReceipt: %w", err)
+		return nil, fmt.Errorf("failed to load custom bridge: %w", err)
 	}
-	if receipt == nil {
-		return nil, nil, errors.New("transaction receipt not found")
+	l2BridgeAddress, err := l1Bridge.L2Bridge(&bind.CallOpts{Context: ensureContext(ctx)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get l2BridgeAddress: %w", err)
 	}
-	fLogs := make([]*zkTypes.Log, 0)
-	for _, l := range receipt.Logs {
-		if l.Address == utils.L1MessengerAddress && len(l.Topics) > 0 &&
-			bytes.Equal(l.Topics[0].Bytes(), crypto.Keccak256([]byte("L1MessageSent(address,bytes32,bytes)"))) {
-			fLogs = append(fLogs, l)
-		}
+	return NewErc20Bridge(address, l2BridgeAddress, a.clientL1, a.clientL2)
+}
+
+// wethBridgeByEthWithdrawal returns the WETH bridge if it is the L1 receiver of the ETH withdrawal message,
+// which is encoded as abi.encodePacked(IZkSync.finalizeEthWithdrawal.selector, l1Receiver, amount, ...),
+// and the message is the withdrawal message of the WETH bridge.
+func (a *WalletL1) wethBridgeByEthWithdrawal(message []byte) (*WethBridge, bool) {
+	if len(message) < 24 {
+		return nil, false
 	}
-	if len(fLogs) < index+1 {
-		return nil, nil, errors.New("withdrawal log not found")
+	bridge, ok := a.bridges.ByL1Address(common.BytesToAddress(message[4:24]))
+	if !ok {
+		return nil, false
 	}
-	return fLogs[index], receipt.L1BatchTxIndex.ToInt(), nil
+	wethBridge, ok := bridge.(*WethBridge)
+	if !ok {
+		return nil, false
+	}
+	if _, err := wethBridge.ParseWithdrawalMessage(message); err != nil {
+		return nil, false
+	}
+	return wethBridge, true
 }
 
-func (a *WalletL1) getWithdrawalL2ToL1Log(ctx context.Context, withdrawalHash common.Hash, index int) (int, *zkTypes.L2ToL1Log, error) {
-	receipt, err := (*a.clientL2).TransactionReceipt(ctx, withdrawalHash)
+// withdrawalBridge returns the bridge that sent the withdrawal message from L2 network,
+// and checks that the message is the withdrawal message of that bridge.
+func (a *WalletL1) withdrawalBridge(ctx context.Context, proof *L2MessageProof) (Bridge, error) {
+	bridge, err := a.bridgeByL2Address(ctx, proof.Sender)
 	if err != nil {
-		return 0, nil, fmt.Errorf("failed to get TransactionReceipt: %w", err)
+		return nil, err
 	}
-	if receipt == nil {
-		return 0, nil, errors.New("transaction receipt not found")
+	if _, err = bridge.ParseWithdrawalMessage(proof.Message); err != nil {
+		return nil, fmt.Errorf("failed to parse withdrawal message: %w", err)
 	}
-	fLogs := make([]struct {
-		i int
-		l *zkTypes.L2ToL1Log
-	}, 0)
-	for i, l := range receipt.L2ToL1Logs {
-		if l.Sender == utils.L1MessengerAddress {
-			fLogs = append(fLogs, struct {
-				i int
-				l *zkTypes.L2ToL1Log
-			}{i, l})
-		}
+	return bridge, nil
+}
+
+// bridgeByL2Address returns the bridge registered at the L2 address. If there is no such bridge,
+// the bridge is assumed to share the ABI of the default ERC20 bridge.
+func (a *WalletL1) bridgeByL2Address(ctx context.Context, address common.Address) (Bridge, error) {
+	if bridge, ok := a.bridges.ByL2Address(address); ok {
+		return bridge, nil
 	}
-	if len(fLogs) < index+1 {
-		return 0, nil, errors.New("withdrawal L2ToL1 log not found")
+	l2Bridge, err := l2bridge.NewIL2Bridge(address, *a.clientL2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init l2Bridge: %w", err)
+	}
+	l1BridgeAddress, err := l2Bridge.L1Bridge(&bind.CallOpts{Context: ensureContext(ctx)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get l1BridgeAddress: %w", err)
 	}
-	return fLogs[index].i, fLogs[index].l, nil
+	return NewErc20Bridge(l1BridgeAddress, address, a.clientL1, a.clientL2)
 }
 
 func (a *WalletL1) checkIfL1ChainIsLondonReady(ctx context.Context) (bool, *types.Header, error) {
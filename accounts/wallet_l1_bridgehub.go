@@ -0,0 +1,291 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/zksync-sdk/zksync2-go/contracts/bridgehub"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+)
+
+// bridgehubDeposit represents the deposit to the chain whose base token is not ETH, prepared for
+// submission to the Bridgehub.
+type bridgehubDeposit struct {
+	opts      *TransactOpts
+	tx        *DepositTransaction
+	baseCost  *big.Int // The base cost of the L2 transaction in the base token.
+	mintValue *big.Int // The amount of the base token minted on L2 network.
+}
+
+// depositViaBridgehub deposits the token to the chain whose base token is not ETH. The base token is deposited
+// with Bridgehub.requestL2TransactionDirect, while ETH and the other tokens are deposited through the shared
+// bridge with Bridgehub.requestL2TransactionTwoBridges. In both cases the base cost of the L2 transaction is
+// paid by minting the base token, which must be approved for the shared bridge.
+func (a *WalletL1) depositViaBridgehub(auth *TransactOpts, tx DepositTransaction) (*DepositHandle, error) {
+	deposit, err := a.prepareBridgehubDeposit(*auth, tx)
+	if err != nil {
+		return nil, err
+	}
+	if deposit.tx.ApproveBaseERC20 {
+		if err = a.approveAllowance(deposit.opts, deposit.tx.ApproveBaseAuth, a.baseToken, a.sharedBridgeAddress, deposit.mintValue); err != nil {
+			return nil, err
+		}
+	}
+	isBaseToken := deposit.tx.Token == a.baseToken
+	if !isBaseToken && deposit.tx.Token != utils.EthAddress && deposit.tx.ApproveERC20 {
+		if err = a.approveAllowance(deposit.opts, deposit.tx.ApproveAuth, deposit.tx.Token, a.sharedBridgeAddress, deposit.tx.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	calldata, err := a.bridgehubDepositCalldata(deposit)
+	if err != nil {
+		return nil, err
+	}
+	l1Tx, err := a.transactBridgehub(deposit.opts, calldata)
+	if err != nil {
+		return nil, err
+	}
+	handle := NewDepositHandle(l1Tx, deposit.tx.Token, a.clientL1, a.clientL2, a)
+	// The failed deposit of the base token is refunded on L2 like ETH on ETH-based chains,
+	// while ETH is claimable through the shared bridge like any other token.
+	handle.refundedOnL2 = isBaseToken
+	return handle, nil
+}
+
+func (a *WalletL1) estimateGasDepositViaBridgehub(ctx context.Context, msg DepositCallMsg) (uint64, error) {
+	deposit, err := a.prepareBridgehubDeposit(msg.ToTransactOpts(), msg.ToDepositTransaction())
+	if err != nil {
+		return 0, err
+	}
+	calldata, err := a.bridgehubDepositCalldata(deposit)
+	if err != nil {
+		return 0, err
+	}
+	return a.clientL1.EstimateGas(ensureContext(ctx), ethereum.CallMsg{
+		From:      a.auth.From,
+		To:        &a.bridgehubAddress,
+		GasPrice:  deposit.opts.GasPrice,
+		GasFeeCap: deposit.opts.GasFeeCap,
+		GasTipCap: deposit.opts.GasTipCap,
+		Value:     deposit.opts.Value,
+		Data:      calldata,
+	})
+}
+
+func (a *WalletL1) fullRequiredDepositFeeViaBridgehub(ctx context.Context, msg DepositCallMsg) (*FullDepositFee, error) {
+	// It is assumed that the L2 fee for the transaction does not depend on its value.
+	msg.Amount = big.NewInt(1)
+	deposit, err := a.prepareBridgehubDeposit(TransactOpts{Context: ensureContext(ctx)}, msg.ToDepositTransaction())
+	if err != nil {
+		return nil, err
+	}
+
+	baseTokenBalance, err := a.BalanceL1(&CallOpts{Context: ensureContext(ctx)}, a.baseToken)
+	if err != nil {
+		return nil, err
+	}
+	if baseTokenBalance.Cmp(deposit.mintValue) < 0 {
+		return nil, fmt.Errorf("not enough base token balance for deposit, the required amount is %v", deposit.mintValue)
+	}
+	baseTokenAllowance, err := a.AllowanceL1(&CallOpts{Context: ensureContext(ctx)}, a.baseToken, a.sharedBridgeAddress)
+	if err != nil {
+		return nil, err
+	}
+	if baseTokenAllowance.Cmp(deposit.mintValue) < 0 {
+		return nil, errors.New("not enough base token allowance to cover the deposit")
+	}
+	if deposit.tx.Token != a.baseToken && deposit.tx.Token != utils.EthAddress {
+		allowance, errAllowance := a.AllowanceL1(&CallOpts{Context: ensureContext(ctx)}, deposit.tx.Token, a.sharedBridgeAddress)
+		if errAllowance != nil {
+			return nil, errAllowance
+		}
+		if allowance.Cmp(deposit.tx.Amount) < 0 {
+			return nil, errors.New("not enough allowance to cover the deposit")
+		}
+	}
+
+	l1GasLimit, err := a.estimateGasDepositViaBridgehub(ensureContext(ctx), DepositCallMsg{
+		To:                deposit.tx.To,
+		Token:             deposit.tx.Token,
+		Amount:            deposit.tx.Amount,
+		OperatorTip:       deposit.tx.OperatorTip,
+		L2GasLimit:        deposit.tx.L2GasLimit,
+		GasPerPubdataByte: deposit.tx.GasPerPubdataByte,
+		RefundRecipient:   deposit.tx.RefundRecipient,
+		CustomBridgeData:  deposit.tx.CustomBridgeData,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fee := &FullDepositFee{
+		BaseCost:   deposit.baseCost,
+		L1GasLimit: new(big.Int).SetUint64(l1GasLimit),
+		L2GasLimit: deposit.tx.L2GasLimit,
+	}
+	if deposit.opts.GasPrice != nil {
+		fee.GasPrice = deposit.opts.GasPrice
+	} else {
+		fee.MaxPriorityFeePerGas = deposit.opts.GasTipCap
+		fee.MaxFeePerGas = deposit.opts.GasFeeCap
+	}
+	return fee, nil
+}
+
+func (a *WalletL1) prepareBridgehubDeposit(auth TransactOpts, tx DepositTransaction) (*bridgehubDeposit, error) {
+	opts := ensureTransactOpts(&auth)
+	tx.PopulateEmptyFields(a.auth.From)
+	if tx.BridgeAddress != nil && *tx.BridgeAddress != a.sharedBridgeAddress {
+		return nil, errors.New("only the shared bridge can be used on chains whose base token is not ETH")
+	}
+	isBaseToken := tx.Token == a.baseToken
+	if isBaseToken && tx.RefundRecipient == (common.Address{}) {
+		tx.RefundRecipient = tx.To
+	}
+
+	if tx.L2GasLimit == nil {
+		var gas uint64
+		var err error
+		if isBaseToken {
+			gas, err = (*a.clientL2).EstimateL1ToL2Execute(opts.Context, zkTypes.CallMsg{
+				CallMsg: ethereum.CallMsg{
+					From:  a.auth.From,
+					To:    &tx.To,
+					Value: tx.Amount,
+				},
+				Meta: &zkTypes.Eip712Meta{
+					GasPerPubdata: utils.NewBig(tx.GasPerPubdataByte.Int64()),
+				},
+			})
+		} else {
+			gas, err = a.bridges.Default().EstimateDepositL2Gas(opts.Context, &tx, a.auth.From)
+		}
+		if err != nil {
+			return nil, err
+		}
+		tx.L2GasLimit = new(big.Int).SetUint64(gas)
+	}
+
+	if err := a.insertGasPriceInTransactOpts(&opts); err != nil {
+		return nil, err
+	}
+	gasPriceForEstimation := opts.GasPrice
+	if opts.GasFeeCap != nil {
+		gasPriceForEstimation = opts.GasFeeCap
+	}
+	baseCost, err := a.BaseCost(&CallOpts{Context: opts.Context}, tx.L2GasLimit, tx.GasPerPubdataByte, gasPriceForEstimation)
+	if err != nil {
+		return nil, err
+	}
+
+	mintValue := new(big.Int).Add(baseCost, tx.OperatorTip)
+	if isBaseToken {
+		mintValue.Add(mintValue, tx.Amount)
+	}
+	// ETH is the only token that is sent along with the L1 transaction, the base token is transferred by the shared bridge.
+	if tx.Token == utils.EthAddress {
+		opts.Value = tx.Amount
+	} else {
+		opts.Value = big.NewInt(0)
+	}
+	return &bridgehubDeposit{opts: opts, tx: &tx, baseCost: baseCost, mintValue: mintValue}, nil
+}
+
+// bridgehubDepositCalldata returns the calldata of the Bridgehub request for the deposit.
+func (a *WalletL1) bridgehubDepositCalldata(deposit *bridgehubDeposit) ([]byte, error) {
+	bridgehubAbi, err := bridgehub.IBridgehubMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IBridgehub ABI: %w", err)
+	}
+	tx := deposit.tx
+	if tx.Token == a.baseToken {
+		return bridgehubAbi.Pack("requestL2TransactionDirect", bridgehub.L2TransactionRequestDirect{
+			ChainId:                  a.chainID,
+			MintValue:                deposit.mintValue,
+			L2Contract:               tx.To,
+			L2Value:                  tx.Amount,
+			L2Calldata:               []byte{},
+			L2GasLimit:               tx.L2GasLimit,
+			L2GasPerPubdataByteLimit: tx.GasPerPubdataByte,
+			FactoryDeps:              [][]byte{},
+			RefundRecipient:          tx.RefundRecipient,
+		})
+	}
+
+	// The shared bridge takes the deposited ETH from the value of the transaction,
+	// so its amount in the bridge calldata must be zero.
+	token, amount, secondBridgeValue := tx.Token, tx.Amount, big.NewInt(0)
+	if token == utils.EthAddress {
+		token, amount, secondBridgeValue = utils.EthAddressInContracts, big.NewInt(0), tx.Amount
+	}
+	secondBridgeCalldata, err := sharedBridgeDepositData(token, amount, tx.To)
+	if err != nil {
+		return nil, err
+	}
+	return bridgehubAbi.Pack("requestL2TransactionTwoBridges", bridgehub.L2TransactionRequestTwoBridgesOuter{
+		ChainId:                  a.chainID,
+		MintValue:                deposit.mintValue,
+		L2Value:                  big.NewInt(0),
+		L2GasLimit:               tx.L2GasLimit,
+		L2GasPerPubdataByteLimit: tx.GasPerPubdataByte,
+		RefundRecipient:          tx.RefundRecipient,
+		SecondBridgeAddress:      a.sharedBridgeAddress,
+		SecondBridgeValue:        secondBridgeValue,
+		SecondBridgeCalldata:     secondBridgeCalldata,
+	})
+}
+
+// bridgehubRequestExecuteCalldata returns the calldata of the Bridgehub request for the execution
+// of the L2 transaction, which mints the provided amount of the base token on L2 network.
+func (a *WalletL1) bridgehubRequestExecuteCalldata(tx *RequestExecuteTransaction, mintValue *big.Int) ([]byte, error) {
+	bridgehubAbi, err := bridgehub.IBridgehubMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IBridgehub ABI: %w", err)
+	}
+	calldata, factoryDeps := tx.Calldata, tx.FactoryDeps
+	if calldata == nil {
+		calldata = []byte{}
+	}
+	if factoryDeps == nil {
+		factoryDeps = [][]byte{}
+	}
+	return bridgehubAbi.Pack("requestL2TransactionDirect", bridgehub.L2TransactionRequestDirect{
+		ChainId:                  a.chainID,
+		MintValue:                mintValue,
+		L2Contract:               tx.ContractAddress,
+		L2Value:                  tx.L2Value,
+		L2Calldata:               calldata,
+		L2GasLimit:               tx.L2GasLimit,
+		L2GasPerPubdataByteLimit: tx.GasPerPubdataByte,
+		FactoryDeps:              factoryDeps,
+		RefundRecipient:          tx.RefundRecipient,
+	})
+}
+
+// transactBridgehub sends the transaction with the provided calldata to the Bridgehub.
+func (a *WalletL1) transactBridgehub(opts *TransactOpts, calldata []byte) (*types.Transaction, error) {
+	contract := bind.NewBoundContract(a.bridgehubAddress, abi.ABI{}, a.clientL1, a.clientL1, a.clientL1)
+	return contract.RawTransact(opts.ToTransactOpts(a.auth.From, a.auth.Signer), calldata)
+}
+
+// sharedBridgeDepositData returns the data that the Bridgehub passes to the shared bridge on deposit,
+// which is encoded as abi.encode(l1Token, amount, l2Receiver).
+func sharedBridgeDepositData(token common.Address, amount *big.Int, l2Receiver common.Address) ([]byte, error) {
+	addressType, err := abi.NewType("address", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	return abi.Arguments{{Type: addressType}, {Type: uint256Type}, {Type: addressType}}.Pack(token, amount, l2Receiver)
+}
@@ -0,0 +1,33 @@
+package accounts
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zksync-sdk/zksync2-go/contracts/bridgehub"
+	"math/big"
+	"testing"
+)
+
+func TestWalletL1IsPriorityRequest(t *testing.T) {
+	mainContract := common.HexToAddress("0x0d01")
+	bridgehubAddress := common.HexToAddress("0x0d02")
+	bridge := common.HexToAddress("0x0a01")
+	token := common.HexToAddress("0x0c01")
+	wallet := &WalletL1{
+		mainContractAddress: mainContract,
+		bridgehubAddress:    bridgehubAddress,
+		bridgehub:           &bridgehub.IBridgehub{},
+		bridges:             NewBridgeRegistry(&Erc20Bridge{l1Address: bridge}),
+	}
+	newTx := func(to common.Address, value int64) *types.Transaction {
+		return types.NewTx(&types.DynamicFeeTx{To: &to, Value: big.NewInt(value)})
+	}
+
+	assert.True(t, wallet.isPriorityRequest(newTx(mainContract, 0)), "Transaction to main contract should be priority request")
+	assert.True(t, wallet.isPriorityRequest(newTx(bridgehubAddress, 0)), "Transaction to Bridgehub should be priority request")
+	assert.True(t, wallet.isPriorityRequest(newTx(bridge, 1)), "Deposit through bridge should be priority request")
+	assert.False(t, wallet.isPriorityRequest(newTx(bridge, 0)), "Finalizing withdrawal should not be priority request")
+	assert.False(t, wallet.isPriorityRequest(newTx(token, 0)), "Approval should not be priority request")
+	assert.False(t, wallet.isPriorityRequest(types.NewTx(&types.DynamicFeeTx{})), "Deployment should not be priority request")
+}
@@ -5,12 +5,13 @@ import (
 	"fmt"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/zksync-sdk/zksync2-go/clients"
 	"github.com/zksync-sdk/zksync2-go/contracts/erc20"
 	"github.com/zksync-sdk/zksync2-go/contracts/ethtoken"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1messenger"
 	"github.com/zksync-sdk/zksync2-go/contracts/l2bridge"
+	"github.com/zksync-sdk/zksync2-go/contracts/l2wethbridge"
 	"github.com/zksync-sdk/zksync2-go/contracts/nonceholder"
 	zkTypes "github.com/zksync-sdk/zksync2-go/types"
 	"github.com/zksync-sdk/zksync2-go/utils"
@@ -25,6 +26,10 @@ type WalletL2 struct {
 
 	defaultL2BridgeAddress common.Address
 	defaultL2Bridge        *l2bridge.IL2Bridge
+	wethL2BridgeAddress    common.Address
+	wethL2Bridge           *l2wethbridge.IL2WethBridge
+
+	nonceManager NonceManager
 }
 
 // NewWalletL2 creates an instance of WalletL2 associated with the account provided by the raw private key.
@@ -58,6 +63,12 @@ func NewWalletL2FromSigner(signer *Signer, client *clients.Client) (*WalletL2, e
 	if err != nil {
 		return nil, fmt.Errorf("failed to load IL1Bridge: %w", err)
 	}
+	var wethL2Bridge *l2wethbridge.IL2WethBridge
+	if bridgeContracts.L2WethBridge != (common.Address{}) {
+		if wethL2Bridge, err = l2wethbridge.NewIL2WethBridge(bridgeContracts.L2WethBridge, *client); err != nil {
+			return nil, fmt.Errorf("failed to load IL2WethBridge: %w", err)
+		}
+	}
 	chainId, err := (*client).ChainID(context.Background())
 	auth, err := newTransactorWithSigner(signer, chainId)
 	if err != nil {
@@ -70,11 +81,13 @@ func NewWalletL2FromSigner(signer *Signer, client *clients.Client) (*WalletL2, e
 		auth:                   auth,
 		defaultL2BridgeAddress: bridgeContracts.L2Erc20DefaultBridge,
 		defaultL2Bridge:        defaultL2Bridge,
+		wethL2BridgeAddress:    bridgeContracts.L2WethBridge,
+		wethL2Bridge:           wethL2Bridge,
 	}, nil
 }
 
 func (a *WalletL2) Address() common.Address {
-	return a.auth.From
+	return (*a.signer).Address()
 }
 
 func (a *WalletL2) Signer() Signer {
@@ -82,6 +95,10 @@ func (a *WalletL2) Signer() Signer {
 }
 
 func (a *WalletL2) Balance(ctx context.Context, token common.Address, at *big.Int) (*big.Int, error) {
+	token, err := clients.ResolveL2Token(ensureContext(ctx), *a.client, token)
+	if err != nil {
+		return nil, err
+	}
 	if token == utils.EthAddress {
 		return (*a.client).BalanceAt(ensureContext(ctx), a.Address(), at)
 	}
@@ -102,7 +119,7 @@ func (a *WalletL2) AllBalances(ctx context.Context) (map[common.Address]*big.Int
 }
 
 func (a *WalletL2) L2BridgeContracts(_ context.Context) (*zkTypes.L2BridgeContracts, error) {
-	return &zkTypes.L2BridgeContracts{Erc20: a.defaultL2Bridge}, nil
+	return &zkTypes.L2BridgeContracts{Erc20: a.defaultL2Bridge, Weth: a.wethL2Bridge}, nil
 }
 
 // DeploymentNonce returns the deployment nonce of the account.
@@ -115,9 +132,26 @@ func (a *WalletL2) DeploymentNonce(opts *CallOpts) (*big.Int, error) {
 	return nonceHolder.GetDeploymentNonce(callOpts, a.Address())
 }
 
-func (a *WalletL2) Withdraw(auth *TransactOpts, tx WithdrawalTransaction) (*types.Transaction, error) {
-	opts := ensureTransactOpts(auth)
+func (a *WalletL2) Withdraw(auth *TransactOpts, tx WithdrawalTransaction) (*WithdrawalHandle, error) {
+	opts := *ensureTransactOpts(auth)
+	done, err := allocateNonce(opts.Context, a.nonceManager, &opts.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	withdrawTx, err := a.withdraw(&opts, tx)
+	done(err)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithdrawalHandle(withdrawTx, 0, a.client, nil), nil
+}
 
+func (a *WalletL2) withdraw(opts *TransactOpts, tx WithdrawalTransaction) (*types.Transaction, error) {
+	var err error
+	tx.Token, err = clients.ResolveL2Token(opts.Context, *a.client, tx.Token)
+	if err != nil {
+		return nil, err
+	}
 	if tx.Token == utils.EthAddress {
 		eth, err := ethtoken.NewIEthToken(utils.L2EthTokenAddress, *a.client)
 		if err != nil {
@@ -130,7 +164,15 @@ func (a *WalletL2) Withdraw(auth *TransactOpts, tx WithdrawalTransaction) (*type
 		return withdrawTx, nil
 	} else {
 		if tx.BridgeAddress == nil {
-			tx.BridgeAddress = &a.defaultL2BridgeAddress
+			isWeth, err := utils.IsL2WethToken(opts.Context, *a.client, a.wethL2BridgeAddress, tx.Token)
+			if err != nil {
+				return nil, err
+			}
+			if isWeth {
+				tx.BridgeAddress = &a.wethL2BridgeAddress
+			} else {
+				tx.BridgeAddress = &a.defaultL2BridgeAddress
+			}
 		}
 		bridge, err := l2bridge.NewIL2Bridge(*tx.BridgeAddress, *a.client)
 		if err != nil {
@@ -144,20 +186,54 @@ func (a *WalletL2) Withdraw(auth *TransactOpts, tx WithdrawalTransaction) (*type
 	}
 }
 
+func (a *WalletL2) SendMessageToL1(auth *TransactOpts, message []byte) (common.Hash, error) {
+	opts := *ensureTransactOpts(auth)
+	messenger, err := l1messenger.NewIL1Messenger(utils.L1MessengerAddress, *a.client)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to load IL1Messenger: %w", err)
+	}
+	done, err := allocateNonce(opts.Context, a.nonceManager, &opts.Nonce)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	tx, err := messenger.SendToL1(opts.ToTransactOpts(a.Address(), a.auth.Signer), message)
+	done(err)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+func (a *WalletL2) L2MessageProof(ctx context.Context, txHash common.Hash, index int) (*L2MessageProof, error) {
+	return l2MessageProof(ensureContext(ctx), a.client, txHash, index)
+}
+
 func (a *WalletL2) EstimateGasWithdraw(ctx context.Context, msg WithdrawalCallMsg) (uint64, error) {
 	return (*a.client).EstimateGasWithdraw(ensureContext(ctx), msg.ToWithdrawalCallMsg(a.Address()))
 }
 
-func (a *WalletL2) Transfer(auth *TransactOpts, tx TransferTransaction) (*types.Transaction, error) {
-	opts := ensureTransactOpts(auth)
+func (a *WalletL2) Transfer(auth *TransactOpts, tx TransferTransaction) (common.Hash, error) {
+	opts := *ensureTransactOpts(auth)
 	if opts.GasLimit == 0 {
-		gas, err := (*a.client).EstimateGasTransfer(opts.Context, tx.ToTransferCallMsg(a.Address(), opts))
+		gas, err := (*a.client).EstimateGasTransfer(opts.Context, tx.ToTransferCallMsg(a.Address(), &opts))
 		if err != nil {
-			return nil, err
+			return common.Hash{}, err
 		}
 		opts.GasLimit = gas
 	}
+	done, err := allocateNonce(opts.Context, a.nonceManager, &opts.Nonce)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	transferTx, err := a.transfer(&opts, tx)
+	done(err)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return transferTx.Hash(), nil
+}
 
+func (a *WalletL2) transfer(opts *TransactOpts, tx TransferTransaction) (*types.Transaction, error) {
 	if tx.Token == utils.EthAddress {
 		return a.transferETH(opts, tx)
 	}
@@ -182,44 +258,22 @@ func (a *WalletL2) PopulateTransaction(ctx context.Context, tx Transaction) (*zk
 	if tx.ChainID == nil {
 		tx.ChainID = (*a.signer).Domain().ChainId
 	}
-	if tx.Nonce == nil {
-		nonce, err := (*a.client).NonceAt(ensureContext(ctx), a.Address(), nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get nonce: %w", err)
-		}
-		tx.Nonce = new(big.Int).SetUint64(nonce)
-	}
-	if tx.GasFeeCap == nil {
-		gasFeeCap, err := (*a.client).SuggestGasPrice(ensureContext(ctx))
-		if err != nil {
-			return nil, fmt.Errorf("failed to SuggestGasPrice: %w", err)
+	return populateTransaction(ensureContext(ctx), *a.client, a.Address(), tx)
+}
+
+func (a *WalletL2) SignTransaction(tx *zkTypes.Transaction712) ([]byte, error) {
+	if a.client == nil {
+		// Without the client the transaction can not be populated, so it is signed as it is.
+		if err := checkTransactionPopulated(tx); err != nil {
+			return nil, err
 		}
-		tx.GasFeeCap = gasFeeCap
-	}
-	if tx.GasTipCap == nil {
-		tx.GasTipCap = big.NewInt(0)
-	}
-	if tx.Meta == nil {
-		tx.Meta = &zkTypes.Eip712Meta{GasPerPubdata: utils.NewBig(utils.DefaultGasPerPubdataLimit.Int64())}
-	} else if tx.Meta.GasPerPubdata == nil {
-		tx.Meta.GasPerPubdata = utils.NewBig(utils.DefaultGasPerPubdataLimit.Int64())
-	}
-	if tx.Gas == 0 {
-		gas, err := (*a.client).EstimateGasL2(ensureContext(ctx), tx.ToCallMsg(a.Address()))
+		signature, err := (*a.signer).SignTypedData((*a.signer).Domain(), tx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to EstimateGasL2: %w", err)
+			return nil, err
 		}
-		tx.Gas = gas
-
-	}
-	if tx.Data == nil {
-		tx.Data = hexutil.Bytes{}
+		return tx.RLPValues(signature)
 	}
 
-	return tx.ToTransaction712(a.auth.From), nil
-}
-
-func (a *WalletL2) SignTransaction(tx *zkTypes.Transaction712) ([]byte, error) {
 	var gas uint64 = 0
 	if tx.Gas != nil {
 		gas = tx.Gas.Uint64()
@@ -247,7 +301,90 @@ func (a *WalletL2) SignTransaction(tx *zkTypes.Transaction712) ([]byte, error) {
 	return preparedTx.RLPValues(signature)
 }
 
+// checkTransactionPopulated checks that the fields required for signing are set in the transaction.
+func checkTransactionPopulated(tx *zkTypes.Transaction712) error {
+	missing := ""
+	switch {
+	case tx.ChainID == nil:
+		missing = "ChainID"
+	case tx.Nonce == nil:
+		missing = "Nonce"
+	case tx.Gas == nil:
+		missing = "Gas"
+	case tx.GasFeeCap == nil:
+		missing = "GasFeeCap"
+	case tx.GasTipCap == nil:
+		missing = "GasTipCap"
+	case tx.From == nil:
+		missing = "From"
+	case tx.To == nil:
+		missing = "To"
+	case tx.Meta == nil || tx.Meta.GasPerPubdata == nil:
+		missing = "Meta.GasPerPubdata"
+	default:
+		return nil
+	}
+	return fmt.Errorf("transaction field %s must be set when the client is not provided", missing)
+}
+
 func (a *WalletL2) SendTransaction(ctx context.Context, tx *Transaction) (common.Hash, error) {
+	transaction := *tx
+	done, err := allocateNonce(ctx, a.nonceManager, &transaction.Nonce)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	hash, err := a.sendTransaction(ctx, &transaction)
+	done(err)
+	return hash, err
+}
+
+func (a *WalletL2) CancelTransaction(ctx context.Context, hash common.Hash, bumpPercent uint64) (common.Hash, error) {
+	cancellation, err := cancellationTransaction(ensureContext(ctx), *a.client, a.Address(), hash, bumpPercent)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return a.sendTransaction(ctx, cancellation)
+}
+
+func (a *WalletL2) ReplaceTransaction(ctx context.Context, hash common.Hash, meta *zkTypes.Eip712Meta, bumpPercent uint64) (common.Hash, error) {
+	replacement, err := replacementTransaction(ensureContext(ctx), *a.client, a.Address(), hash, meta, bumpPercent)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return a.sendTransaction(ctx, replacement)
+}
+
+// SetNonceManager sets the manager that allocates the nonces of the transactions sent by
+// WalletL2.SendTransaction, WalletL2.Transfer and WalletL2.Withdraw when the nonce is not provided.
+// Setting nil restores the default behavior of fetching the nonce from the network for every transaction.
+func (a *WalletL2) SetNonceManager(manager NonceManager) {
+	a.nonceManager = manager
+}
+
+// FillNonceGaps sends a zero-value transfer to the account itself for each gap reported by the nonce manager,
+// which unblocks the transactions with higher nonces. It returns the hashes of the sent transactions.
+func (a *WalletL2) FillNonceGaps(ctx context.Context) ([]common.Hash, error) {
+	if a.nonceManager == nil {
+		return nil, errNonceManagerNotSet
+	}
+	gaps := a.nonceManager.Gaps()
+	hashes := make([]common.Hash, 0, len(gaps))
+	for range gaps {
+		if len(a.nonceManager.Gaps()) == 0 {
+			break
+		}
+		// the nonce manager allocates the gaps before the new nonces
+		to := a.Address()
+		hash, err := a.SendTransaction(ctx, &Transaction{To: &to, Value: big.NewInt(0)})
+		if err != nil {
+			return hashes, fmt.Errorf("failed to fill nonce gap: %w", err)
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
+
+func (a *WalletL2) sendTransaction(ctx context.Context, tx *Transaction) (common.Hash, error) {
 	preparedTx, err := a.PopulateTransaction(ensureContext(ctx), *tx)
 	if err != nil {
 		return common.Hash{}, err
@@ -278,8 +415,11 @@ func (a *WalletL2) transferETH(auth *TransactOpts, tx TransferTransaction) (*typ
 				Value:    tx.Amount,
 			})
 
-		signedTx, _ := types.SignTx(transaction, types.NewLondonSigner((*a.signer).Domain().ChainId), (*a.signer).PrivateKey())
-		err := (*a.client).SendTransaction(auth.Context, signedTx)
+		signedTx, err := a.auth.Signer(a.Address(), transaction)
+		if err != nil {
+			return nil, err
+		}
+		err = (*a.client).SendTransaction(auth.Context, signedTx)
 		if err != nil {
 			return nil, err
 		}
@@ -299,7 +439,10 @@ func (a *WalletL2) transferETH(auth *TransactOpts, tx TransferTransaction) (*typ
 				To:        preparedTx.To,
 				Value:     preparedTx.Value,
 			})
-		signedTx, _ := types.SignTx(transaction, types.NewLondonSigner((*a.signer).Domain().ChainId), (*a.signer).PrivateKey())
+		signedTx, err := a.auth.Signer(a.Address(), transaction)
+		if err != nil {
+			return nil, err
+		}
 		err = (*a.client).SendTransaction(auth.Context, signedTx)
 		if err != nil {
 			return nil, err
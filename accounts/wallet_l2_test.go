@@ -0,0 +1,220 @@
+package accounts
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"github.com/zksync-sdk/zksync2-go/zksynctest"
+	"math/big"
+	"testing"
+)
+
+// replacementTestClient is the stand-in for the client which serves the pending transaction.
+type replacementTestClient struct {
+	zkSyncClient
+	pendingTx *zkTypes.TransactionResponse
+	sent      [][]byte
+}
+
+func (c *replacementTestClient) TransactionByHash(_ context.Context, _ common.Hash) (*zkTypes.TransactionResponse, bool, error) {
+	return c.pendingTx, true, nil
+}
+
+func (c *replacementTestClient) ChainID(_ context.Context) (*big.Int, error) {
+	return big.NewInt(270), nil
+}
+
+func (c *replacementTestClient) SuggestGasPrice(_ context.Context) (*big.Int, error) {
+	return big.NewInt(100_000_000), nil
+}
+
+func (c *replacementTestClient) EstimateGasL2(_ context.Context, _ zkTypes.CallMsg) (uint64, error) {
+	return 300_000, nil
+}
+
+func (c *replacementTestClient) SendRawTransaction(_ context.Context, tx []byte) (common.Hash, error) {
+	c.sent = append(c.sent, tx)
+	return crypto.Keccak256Hash(tx), nil
+}
+
+func newReplacementTestWallet(t *testing.T) (*WalletL2, *replacementTestClient) {
+	signer, err := NewBaseSignerFromRawPrivateKey(common.Hex2Bytes("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110"), 270)
+	assert.NoError(t, err, "NewBaseSignerFromRawPrivateKey should not return error")
+	client := &replacementTestClient{pendingTx: &zkTypes.TransactionResponse{
+		From:                 signer.Address(),
+		To:                   common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618"),
+		Data:                 hexutil.MustDecode("0xa9059cbb"),
+		Value:                hexutil.Big(*big.NewInt(1_000)),
+		Nonce:                7,
+		Gas:                  500_000,
+		MaxFeePerGas:         hexutil.Big(*big.NewInt(250_000_000)),
+		MaxPriorityFeePerGas: hexutil.Big(*big.NewInt(0)),
+	}}
+	s := Signer(signer)
+	return &WalletL2{signer: &s}, client
+}
+
+func TestWalletL2CancelTransaction(t *testing.T) {
+	wallet, client := newReplacementTestWallet(t)
+	wallet.client = toClient(client)
+
+	_, err := wallet.CancelTransaction(context.Background(), common.Hash{}, 0)
+	assert.NoError(t, err, "CancelTransaction should not return error")
+	assert.Len(t, client.sent, 1, "One transaction should be sent")
+
+	tx, _, err := zkTypes.DecodeTransaction712(client.sent[0])
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	assert.Equal(t, big.NewInt(7), tx.Nonce, "Nonce should be the same as pending transaction nonce")
+	assert.Equal(t, wallet.Address(), *tx.To, "Recipient should be the account itself")
+	assert.Equal(t, 0, tx.Value.Sign(), "Value should be zero")
+	assert.Equal(t, big.NewInt(275_000_000), tx.GasFeeCap, "Fee should be bumped by default percentage")
+}
+
+func TestWalletL2ReplaceTransaction(t *testing.T) {
+	wallet, client := newReplacementTestWallet(t)
+	wallet.client = toClient(client)
+
+	_, err := wallet.ReplaceTransaction(context.Background(), common.Hash{}, nil, 50)
+	assert.NoError(t, err, "ReplaceTransaction should not return error")
+
+	tx, _, err := zkTypes.DecodeTransaction712(client.sent[0])
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	assert.Equal(t, big.NewInt(7), tx.Nonce, "Nonce should be the same as pending transaction nonce")
+	assert.Equal(t, client.pendingTx.To, *tx.To, "Recipient should be the same")
+	assert.Equal(t, client.pendingTx.Data, tx.Data, "Data should be the same")
+	assert.Equal(t, big.NewInt(1_000), tx.Value, "Value should be the same")
+	assert.Equal(t, big.NewInt(375_000_000), tx.GasFeeCap, "Fee should be bumped by specified percentage")
+}
+
+func TestWalletL2ReplaceTransactionWithMeta(t *testing.T) {
+	wallet, client := newReplacementTestWallet(t)
+	wallet.client = toClient(client)
+	meta := &zkTypes.Eip712Meta{
+		GasPerPubdata: utils.NewBig(800),
+		PaymasterParams: &zkTypes.PaymasterParams{
+			Paymaster:      common.HexToAddress("0x13D0D8550769f59aa241a41897D4859c87f7Dd46"),
+			PaymasterInput: hexutil.MustDecode("0x8c5a3445"),
+		},
+	}
+
+	_, err := wallet.ReplaceTransaction(context.Background(), common.Hash{}, meta, 0)
+	assert.NoError(t, err, "ReplaceTransaction should not return error")
+
+	tx, _, err := zkTypes.DecodeTransaction712(client.sent[0])
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	assert.Equal(t, meta.GasPerPubdata, tx.Meta.GasPerPubdata, "Gas per pubdata should be the same")
+	assert.Equal(t, meta.PaymasterParams, tx.Meta.PaymasterParams, "Paymaster parameters should be the same")
+}
+
+func TestWalletL2ReplaceTransactionWithoutRecipient(t *testing.T) {
+	wallet, client := newReplacementTestWallet(t)
+	client.pendingTx.To = common.Address{}
+	wallet.client = toClient(client)
+
+	_, err := wallet.ReplaceTransaction(context.Background(), common.Hash{}, nil, 0)
+	assert.Error(t, err, "ReplaceTransaction should return error for transaction without recipient")
+	assert.Empty(t, client.sent, "No transaction should be sent")
+}
+
+func TestWalletL2CancelTransactionFromOtherAccount(t *testing.T) {
+	wallet, client := newReplacementTestWallet(t)
+	client.pendingTx.From = common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618")
+	wallet.client = toClient(client)
+
+	_, err := wallet.CancelTransaction(context.Background(), common.Hash{}, 0)
+	assert.Error(t, err, "CancelTransaction should return error for transaction from other account")
+}
+
+func TestBumpFee(t *testing.T) {
+	assert.Equal(t, big.NewInt(110), bumpFee(big.NewInt(100), 0), "Fee should be bumped by default percentage")
+	assert.Equal(t, big.NewInt(12), bumpFee(big.NewInt(10), 15), "Bumped fee should be rounded up")
+	assert.Equal(t, 0, bumpFee(big.NewInt(0), 10).Sign(), "Zero fee should stay zero")
+}
+
+func TestWalletL2TransferOnFakeNode(t *testing.T) {
+	node := zksynctest.NewNode(zksynctest.Config{})
+	defer node.Close()
+	client := node.Client()
+	wallet, err := NewWalletL2(common.Hex2Bytes("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110"), &client)
+	assert.NoError(t, err, "NewWalletL2 should not return error")
+	receiver := common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618")
+	node.SetBalance(wallet.Address(), big.NewInt(1_000_000_000_000_000_000))
+
+	hash, err := wallet.Transfer(nil, TransferTransaction{To: receiver, Amount: big.NewInt(1_000)})
+	assert.NoError(t, err, "Transfer should not return error")
+	receipt, err := client.WaitMined(context.Background(), hash)
+	assert.NoError(t, err, "WaitMined should not return error")
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status, "Transfer should succeed")
+	assert.Equal(t, big.NewInt(1_000), node.Balance(receiver), "Balances should be the same")
+
+	token := common.HexToAddress("0x3e7676937A7E96CFB7616f255b9AD9FF47363D4b")
+	node.AddToken(token)
+	node.SetTokenBalance(token, wallet.Address(), big.NewInt(5_000))
+	hash, err = wallet.Transfer(nil, TransferTransaction{To: receiver, Amount: big.NewInt(2_000), Token: token})
+	assert.NoError(t, err, "Transfer should not return error")
+	receipt, err = client.WaitMined(context.Background(), hash)
+	assert.NoError(t, err, "WaitMined should not return error")
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status, "Transfer should succeed")
+	assert.Equal(t, big.NewInt(2_000), node.TokenBalance(token, receiver), "Balances should be the same")
+	assert.Equal(t, big.NewInt(3_000), node.TokenBalance(token, wallet.Address()), "Balances should be the same")
+}
+
+func TestWalletL2TransferReusedOpts(t *testing.T) {
+	node := zksynctest.NewNode(zksynctest.Config{})
+	defer node.Close()
+	client := node.Client()
+	wallet, err := NewWalletL2(common.Hex2Bytes("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110"), &client)
+	assert.NoError(t, err, "NewWalletL2 should not return error")
+	wallet.SetNonceManager(NewSequentialNonceManager(&client, wallet.Address()))
+	receiver := common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618")
+	node.SetBalance(wallet.Address(), big.NewInt(1_000_000_000_000_000_000))
+
+	opts := &TransactOpts{Context: context.Background()}
+	first, err := wallet.Transfer(opts, TransferTransaction{To: receiver, Amount: big.NewInt(1_000)})
+	assert.NoError(t, err, "Transfer should not return error")
+	second, err := wallet.Transfer(opts, TransferTransaction{To: receiver, Amount: big.NewInt(1_000)})
+	assert.NoError(t, err, "Transfer should not return error for reused options")
+	assert.Nil(t, opts.Nonce, "Allocated nonce should not be written to options")
+	firstTx, _, err := client.TransactionByHash(context.Background(), first)
+	assert.NoError(t, err, "TransactionByHash should not return error")
+	assert.Equal(t, uint64(0), uint64(firstTx.Nonce), "Nonces should be the same")
+	secondTx, _, err := client.TransactionByHash(context.Background(), second)
+	assert.NoError(t, err, "TransactionByHash should not return error")
+	assert.Equal(t, uint64(1), uint64(secondTx.Nonce), "Nonces should be the same")
+	assert.Equal(t, big.NewInt(2_000), node.Balance(receiver), "Balances should be the same")
+}
+
+func TestWalletL2SignTransactionWithoutClient(t *testing.T) {
+	baseSigner, err := NewBaseSignerFromRawPrivateKey(common.Hex2Bytes("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110"), 270)
+	assert.NoError(t, err, "NewBaseSignerFromRawPrivateKey should not return error")
+	signer := Signer(baseSigner)
+	wallet, err := NewWalletL2FromSigner(&signer, nil)
+	assert.NoError(t, err, "NewWalletL2FromSigner should not return error")
+	from := wallet.Address()
+	receiver := common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618")
+
+	tx := &zkTypes.Transaction712{
+		ChainID:   big.NewInt(270),
+		Nonce:     big.NewInt(0),
+		GasFeeCap: big.NewInt(100_000_000),
+		From:      &from,
+		To:        &receiver,
+	}
+	_, err = wallet.SignTransaction(tx)
+	assert.EqualError(t, err, "transaction field Gas must be set when the client is not provided", "SignTransaction should return error for partial transaction")
+
+	tx.Gas = big.NewInt(200_000)
+	tx.GasTipCap = big.NewInt(0)
+	_, err = wallet.SignTransaction(tx)
+	assert.Error(t, err, "SignTransaction should return error for transaction without gas per pubdata")
+
+	tx.Meta = &zkTypes.Eip712Meta{GasPerPubdata: utils.NewBig(utils.DefaultGasPerPubdataLimit.Int64())}
+	raw, err := wallet.SignTransaction(tx)
+	assert.NoError(t, err, "SignTransaction should not return error for populated transaction")
+	assert.NotEmpty(t, raw, "Signed transaction should not be empty")
+}
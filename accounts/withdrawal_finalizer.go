@@ -0,0 +1,309 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+	"time"
+)
+
+// l1MessageSentTopic is the topic of the L1MessageSent event emitted by the L1Messenger system contract
+// for every L2 -> L1 message, including the withdrawals.
+var l1MessageSentTopic = crypto.Keccak256Hash([]byte("L1MessageSent(address,bytes32,bytes)"))
+
+const (
+	defaultFinalizerBlockRange    uint64 = 1_000
+	defaultFinalizerPollInterval         = 30 * time.Second
+	defaultFinalizerResendTimeout        = 10 * time.Minute
+)
+
+// WithdrawalFinalizer is a long-running service that finalizes the withdrawals initiated by the set of L2 accounts.
+// It scans the L2 blocks for the L1MessageSent events emitted by the withdrawals of the accounts, tracks the
+// withdrawals until their batches are executed on L1, and then finalizes them using AdapterL1.FinalizeWithdraw.
+// The withdrawals that are already finalized, for example by another party, are detected using
+// AdapterL1.IsWithdrawFinalized and skipped. The progress is persisted through the WithdrawalStore,
+// so the service resumes from where it left off after restart.
+type WithdrawalFinalizer struct {
+	StartBlock    uint64        // The first L2 block to scan if the store contains no progress.
+	BlockRange    uint64        // The maximum number of L2 blocks fetched in a single log filter query.
+	PollInterval  time.Duration // The interval between iterations in WithdrawalFinalizer.Run.
+	ResendTimeout time.Duration // The time after which the finalization transaction is sent again if it is not applied.
+	OnError       func(error)   // Optional callback for errors that occur in WithdrawalFinalizer.Run.
+
+	// BridgeAddresses are the L2 addresses of the custom bridges whose withdrawals are finalized, in addition
+	// to the bridges registered in the BridgeRegistry of the L1 adapter.
+	BridgeAddresses []common.Address
+
+	clientL2  *clients.Client
+	adapterL1 AdapterL1
+	store     WithdrawalStore
+	addresses map[common.Address]struct{}
+	senders   []common.Address
+	bridges   *BridgeRegistry
+}
+
+// NewWithdrawalFinalizer creates a new instance of WithdrawalFinalizer for the withdrawals initiated
+// by the addresses. The finalization transactions are sent using the adapterL1, which is typically
+// the WalletL1 or the Wallet. If the adapterL1 provides the BridgeRegistry, the withdrawals through
+// all registered bridges are finalized, including the bridges registered after the finalizer is created.
+func NewWithdrawalFinalizer(clientL2 *clients.Client, adapterL1 AdapterL1, store WithdrawalStore, addresses []common.Address) (*WithdrawalFinalizer, error) {
+	if clientL2 == nil || adapterL1 == nil || store == nil {
+		return nil, errors.New("client, L1 adapter and store must be provided")
+	}
+	bridgeContracts, err := (*clientL2).BridgeContracts(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bridge contracts: %w", err)
+	}
+	// the withdrawals are sent to L1 by the ETH token and the bridge contracts, where the WETH bridge
+	// withdraws through the ETH token
+	senders := []common.Address{utils.L2EthTokenAddress}
+	if bridgeContracts.L2Erc20DefaultBridge != (common.Address{}) {
+		senders = append(senders, bridgeContracts.L2Erc20DefaultBridge)
+	}
+	tracked := make(map[common.Address]struct{}, len(addresses))
+	for _, address := range addresses {
+		tracked[address] = struct{}{}
+	}
+	return &WithdrawalFinalizer{
+		BlockRange:    defaultFinalizerBlockRange,
+		PollInterval:  defaultFinalizerPollInterval,
+		ResendTimeout: defaultFinalizerResendTimeout,
+		clientL2:      clientL2,
+		adapterL1:     adapterL1,
+		store:         store,
+		addresses:     tracked,
+		senders:       senders,
+		bridges:       bridgeRegistry(adapterL1),
+	}, nil
+}
+
+// Run scans and finalizes the withdrawals until the context is done. The errors of the individual iterations
+// are reported to the OnError callback, and the next iteration is started after the PollInterval.
+func (f *WithdrawalFinalizer) Run(ctx context.Context) error {
+	ctx = ensureContext(ctx)
+	interval := f.PollInterval
+	if interval <= 0 {
+		interval = defaultFinalizerPollInterval
+	}
+	queryTicker := time.NewTicker(interval)
+	defer queryTicker.Stop()
+	for {
+		if _, err := f.Poll(ctx); err != nil && f.OnError != nil && ctx.Err() == nil {
+			f.OnError(err)
+		}
+		// Wait for the next round.
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-queryTicker.C:
+		}
+	}
+}
+
+// Poll performs a single iteration of the service: it scans the new L2 blocks and finalizes the tracked
+// withdrawals that can be finalized. It returns the sent finalization transactions.
+func (f *WithdrawalFinalizer) Poll(ctx context.Context) ([]*types.Transaction, error) {
+	if err := f.Scan(ctx); err != nil {
+		return nil, err
+	}
+	return f.FinalizePending(ctx)
+}
+
+// Scan scans the L2 blocks after the last scanned one, up to the latest block, and adds the found withdrawals
+// of the addresses to the store.
+func (f *WithdrawalFinalizer) Scan(ctx context.Context) error {
+	ctx = ensureContext(ctx)
+	lastScanned, err := f.store.LastScannedBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get last scanned block: %w", err)
+	}
+	latest, err := (*f.clientL2).BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get block number: %w", err)
+	}
+	blockRange := f.BlockRange
+	if blockRange == 0 {
+		blockRange = defaultFinalizerBlockRange
+	}
+
+	from := lastScanned + 1
+	if from < f.StartBlock {
+		from = f.StartBlock
+	}
+	for from <= latest {
+		to := from + blockRange - 1
+		if to > latest {
+			to = latest
+		}
+		if err = f.scanRange(ctx, from, to); err != nil {
+			return err
+		}
+		if err = f.store.SetLastScannedBlock(ctx, to); err != nil {
+			return fmt.Errorf("failed to store last scanned block: %w", err)
+		}
+		from = to + 1
+	}
+	return nil
+}
+
+// FinalizePending finalizes the tracked withdrawals whose batches are executed on L1, and removes
+// the finalized withdrawals from the store. The withdrawals that cannot be finalized yet are left for
+// the next call. It returns the sent finalization transactions.
+func (f *WithdrawalFinalizer) FinalizePending(ctx context.Context) ([]*types.Transaction, error) {
+	ctx = ensureContext(ctx)
+	withdrawals, err := f.store.Withdrawals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get withdrawals: %w", err)
+	}
+	var (
+		txs  []*types.Transaction
+		errs []error
+	)
+	for _, withdrawal := range withdrawals {
+		tx, errFinalize := f.finalize(ctx, withdrawal)
+		if errFinalize != nil {
+			errs = append(errs, fmt.Errorf("failed to finalize withdrawal %s: %w", withdrawal.Hash, errFinalize))
+			continue
+		}
+		if tx != nil {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, errors.Join(errs...)
+}
+
+func (f *WithdrawalFinalizer) scanRange(ctx context.Context, from, to uint64) error {
+	senders := f.senderTopics()
+	logs, err := (*f.clientL2).FilterLogsL2(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{utils.L1MessengerAddress},
+		Topics:    [][]common.Hash{{l1MessageSentTopic}, senders},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to filter logs: %w", err)
+	}
+	scanned := make(map[common.Hash]struct{})
+	for _, log := range logs {
+		if _, ok := scanned[log.TxHash]; ok {
+			continue
+		}
+		scanned[log.TxHash] = struct{}{}
+		if err = f.scanTransaction(ctx, log.TxHash, senders); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanTransaction adds the withdrawals contained in the transaction to the store if the transaction is sent
+// by one of the addresses. The index of the withdrawal matches the index used by AdapterL1.FinalizeWithdraw.
+func (f *WithdrawalFinalizer) scanTransaction(ctx context.Context, hash common.Hash, senders []common.Hash) error {
+	receipt, err := (*f.clientL2).TransactionReceipt(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+	if _, ok := f.addresses[receipt.From]; !ok || receipt.Status != types.ReceiptStatusSuccessful {
+		return nil
+	}
+	index := 0
+	for _, log := range receipt.Logs {
+		if log.Address != utils.L1MessengerAddress || len(log.Topics) == 0 || log.Topics[0] != l1MessageSentTopic {
+			continue
+		}
+		if len(log.Topics) > 1 && isSender(senders, log.Topics[1]) {
+			withdrawal := TrackedWithdrawal{
+				Hash:  hash,
+				Index: index,
+				From:  receipt.From,
+			}
+			if receipt.BlockNumber != nil {
+				withdrawal.BlockNumber = receipt.BlockNumber.Uint64()
+			}
+			if err = f.store.PutWithdrawal(ctx, withdrawal); err != nil {
+				return fmt.Errorf("failed to store withdrawal: %w", err)
+			}
+		}
+		index++
+	}
+	return nil
+}
+
+func (f *WithdrawalFinalizer) finalize(ctx context.Context, withdrawal TrackedWithdrawal) (*types.Transaction, error) {
+	status, err := withdrawalStatus(ctx, f.clientL2, f.adapterL1, withdrawal.Hash, withdrawal.Index)
+	if err != nil {
+		return nil, err
+	}
+	switch status {
+	case WithdrawalFinalized, WithdrawalFailed:
+		return nil, f.store.DeleteWithdrawal(ctx, withdrawal.Hash, withdrawal.Index)
+	case WithdrawalFinalizable:
+	default:
+		return nil, nil
+	}
+
+	resendTimeout := f.ResendTimeout
+	if resendTimeout <= 0 {
+		resendTimeout = defaultFinalizerResendTimeout
+	}
+	// the previously sent finalization transaction may not be mined yet
+	if withdrawal.FinalizeTxHash != nil && time.Since(withdrawal.FinalizeSentAt) < resendTimeout {
+		return nil, nil
+	}
+	tx, err := f.adapterL1.FinalizeWithdraw(&TransactOpts{Context: ctx}, withdrawal.Hash, withdrawal.Index)
+	if err != nil {
+		return nil, err
+	}
+	hash := tx.Hash()
+	withdrawal.FinalizeTxHash = &hash
+	withdrawal.FinalizeSentAt = time.Now()
+	if err = f.store.PutWithdrawal(ctx, withdrawal); err != nil {
+		return tx, fmt.Errorf("failed to store withdrawal: %w", err)
+	}
+	return tx, nil
+}
+
+// senderTopics returns the topics of the L2 contracts that send the withdrawal messages to L1 network.
+func (f *WithdrawalFinalizer) senderTopics() []common.Hash {
+	addresses := append(append([]common.Address{}, f.senders...), f.BridgeAddresses...)
+	if f.bridges != nil {
+		addresses = append(addresses, f.bridges.L2Addresses()...)
+	}
+	seen := make(map[common.Address]struct{}, len(addresses))
+	topics := make([]common.Hash, 0, len(addresses))
+	for _, address := range addresses {
+		if _, ok := seen[address]; ok || address == (common.Address{}) {
+			continue
+		}
+		seen[address] = struct{}{}
+		topics = append(topics, common.BytesToHash(address.Bytes()))
+	}
+	return topics
+}
+
+func isSender(senders []common.Hash, topic common.Hash) bool {
+	for _, sender := range senders {
+		if sender == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// bridgeRegistry returns the BridgeRegistry of the L1 adapter, or nil if the adapter does not provide it.
+func bridgeRegistry(adapterL1 AdapterL1) *BridgeRegistry {
+	if wallet, ok := adapterL1.(*Wallet); ok {
+		adapterL1 = wallet.AdapterL1
+	}
+	if provider, ok := adapterL1.(interface{ Bridges() *BridgeRegistry }); ok {
+		return provider.Bridges()
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package accounts
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+	"path/filepath"
+	"testing"
+)
+
+// finalizerTestClient is the stand-in for the client which serves the blocks containing the withdrawals.
+type finalizerTestClient struct {
+	zkSyncClient
+	latest   uint64
+	receipts map[common.Hash]*zkTypes.Receipt
+	executed bool
+	queries  []ethereum.FilterQuery
+}
+
+func (c *finalizerTestClient) BridgeContracts(_ context.Context) (*zkTypes.BridgeContracts, error) {
+	return &zkTypes.BridgeContracts{L2Erc20DefaultBridge: common.HexToAddress("0x0000000000000000000000000000000000000b1d")}, nil
+}
+
+func (c *finalizerTestClient) BlockNumber(_ context.Context) (uint64, error) {
+	return c.latest, nil
+}
+
+func (c *finalizerTestClient) FilterLogsL2(_ context.Context, query ethereum.FilterQuery) ([]zkTypes.Log, error) {
+	c.queries = append(c.queries, query)
+	var logs []zkTypes.Log
+	for hash, receipt := range c.receipts {
+		block := receipt.BlockNumber.Uint64()
+		if block >= query.FromBlock.Uint64() && block <= query.ToBlock.Uint64() {
+			logs = append(logs, zkTypes.Log{Log: types.Log{TxHash: hash}})
+		}
+	}
+	return logs, nil
+}
+
+func (c *finalizerTestClient) TransactionReceipt(_ context.Context, hash common.Hash) (*zkTypes.Receipt, error) {
+	receipt, ok := c.receipts[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+func (c *finalizerTestClient) TransactionDetails(_ context.Context, _ common.Hash) (*zkTypes.TransactionDetails, error) {
+	return &zkTypes.TransactionDetails{Status: "included"}, nil
+}
+
+func (c *finalizerTestClient) BlockDetails(_ context.Context, _ uint32) (*zkTypes.BlockDetails, error) {
+	if !c.executed {
+		return &zkTypes.BlockDetails{}, nil
+	}
+	hash := common.HexToHash("0x01")
+	return &zkTypes.BlockDetails{CommitTxHash: &hash, ProveTxHash: &hash, ExecuteTxHash: &hash}, nil
+}
+
+func newFinalizerTestReceipt(from common.Address, block int64, senders ...common.Address) *zkTypes.Receipt {
+	receipt := &zkTypes.Receipt{
+		Receipt: types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(block)},
+		From:    from,
+	}
+	for _, sender := range senders {
+		receipt.Logs = append(receipt.Logs, &zkTypes.Log{Log: types.Log{
+			Address: utils.L1MessengerAddress,
+			Topics:  []common.Hash{l1MessageSentTopic, common.BytesToHash(sender.Bytes())},
+		}})
+	}
+	return receipt
+}
+
+func TestWithdrawalFinalizer(t *testing.T) {
+	account := common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049")
+	messenger := common.HexToAddress("0x0000000000000000000000000000000000001234")
+	client := &finalizerTestClient{
+		latest: 25,
+		receipts: map[common.Hash]*zkTypes.Receipt{
+			common.HexToHash("0x0a"): newFinalizerTestReceipt(account, 5, messenger, utils.L2EthTokenAddress),
+			common.HexToHash("0x0b"): newFinalizerTestReceipt(common.HexToAddress("0x0c"), 12, utils.L2EthTokenAddress),
+		},
+	}
+	adapterL1 := &withdrawalTestAdapterL1{}
+	store := NewMemoryWithdrawalStore()
+	finalizer, err := NewWithdrawalFinalizer(toClient(client), adapterL1, store, []common.Address{account})
+	assert.NoError(t, err, "NewWithdrawalFinalizer should not return error")
+	finalizer.BlockRange = 10
+
+	txs, err := finalizer.Poll(context.Background())
+	assert.NoError(t, err, "Poll should not return error")
+	assert.Empty(t, txs, "Withdrawals should not be finalized before execution")
+	assert.Len(t, client.queries, 3, "Blocks should be scanned in ranges")
+	assert.Len(t, client.queries[0].Topics[1], 2, "Logs should be filtered by ETH token and bridge senders")
+
+	lastScanned, err := store.LastScannedBlock(context.Background())
+	assert.NoError(t, err, "LastScannedBlock should not return error")
+	assert.Equal(t, uint64(25), lastScanned, "Last scanned block should be the latest block")
+	withdrawals, err := store.Withdrawals(context.Background())
+	assert.NoError(t, err, "Withdrawals should not return error")
+	assert.Len(t, withdrawals, 1, "Only withdrawal of tracked account should be stored")
+	assert.Equal(t, 1, withdrawals[0].Index, "Index should count all L1MessageSent logs")
+
+	client.executed = true
+	txs, err = finalizer.Poll(context.Background())
+	assert.NoError(t, err, "Poll should not return error")
+	assert.Len(t, txs, 1, "Withdrawal should be finalized")
+	assert.Len(t, client.queries, 3, "Scanned blocks should not be scanned again")
+
+	txs, err = finalizer.Poll(context.Background())
+	assert.NoError(t, err, "Poll should not return error")
+	assert.Empty(t, txs, "Finalized withdrawal should not be finalized again")
+	assert.Equal(t, 1, adapterL1.finalizes, "Withdrawal should be finalized once")
+	withdrawals, err = store.Withdrawals(context.Background())
+	assert.NoError(t, err, "Withdrawals should not return error")
+	assert.Empty(t, withdrawals, "Finalized withdrawal should be removed from store")
+}
+
+// finalizerTestAdapterL1 is the stand-in for the L1 adapter which provides the registry of the bridges.
+type finalizerTestAdapterL1 struct {
+	withdrawalTestAdapterL1
+	bridges *BridgeRegistry
+}
+
+func (a *finalizerTestAdapterL1) Bridges() *BridgeRegistry {
+	return a.bridges
+}
+
+func TestWithdrawalFinalizerRegisteredBridges(t *testing.T) {
+	account := common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049")
+	registeredBridge := &Erc20Bridge{l1Address: common.HexToAddress("0x0a2d"), l2Address: common.HexToAddress("0x0b2d")}
+	customBridge := common.HexToAddress("0x0b3d")
+	client := &finalizerTestClient{
+		latest: 5,
+		receipts: map[common.Hash]*zkTypes.Receipt{
+			common.HexToHash("0x0a"): newFinalizerTestReceipt(account, 2, registeredBridge.L2Address()),
+			common.HexToHash("0x0b"): newFinalizerTestReceipt(account, 3, customBridge),
+		},
+	}
+	adapterL1 := &finalizerTestAdapterL1{
+		bridges: NewBridgeRegistry(&Erc20Bridge{l2Address: common.HexToAddress("0x0000000000000000000000000000000000000b1d")}),
+	}
+	store := NewMemoryWithdrawalStore()
+	finalizer, err := NewWithdrawalFinalizer(toClient(client), adapterL1, store, []common.Address{account})
+	assert.NoError(t, err, "NewWithdrawalFinalizer should not return error")
+	finalizer.BridgeAddresses = []common.Address{customBridge}
+	adapterL1.bridges.Register(registeredBridge)
+
+	assert.NoError(t, finalizer.Scan(context.Background()), "Scan should not return error")
+	assert.Len(t, client.queries[0].Topics[1], 4, "Logs should be filtered by ETH token and all bridge senders")
+	withdrawals, err := store.Withdrawals(context.Background())
+	assert.NoError(t, err, "Withdrawals should not return error")
+	assert.Len(t, withdrawals, 2, "Withdrawals through registered and custom bridges should be stored")
+}
+
+func TestFileWithdrawalStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "withdrawals.json")
+	store, err := NewFileWithdrawalStore(path)
+	assert.NoError(t, err, "NewFileWithdrawalStore should not return error")
+
+	finalizeTxHash := common.HexToHash("0x02")
+	withdrawal := TrackedWithdrawal{
+		Hash:           common.HexToHash("0x01"),
+		Index:          1,
+		From:           common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049"),
+		BlockNumber:    5,
+		FinalizeTxHash: &finalizeTxHash,
+	}
+	assert.NoError(t, store.SetLastScannedBlock(context.Background(), 10), "SetLastScannedBlock should not return error")
+	assert.NoError(t, store.PutWithdrawal(context.Background(), withdrawal), "PutWithdrawal should not return error")
+	assert.NoError(t, store.PutWithdrawal(context.Background(), TrackedWithdrawal{Hash: common.HexToHash("0x03")}), "PutWithdrawal should not return error")
+	assert.NoError(t, store.DeleteWithdrawal(context.Background(), common.HexToHash("0x03"), 0), "DeleteWithdrawal should not return error")
+
+	restored, err := NewFileWithdrawalStore(path)
+	assert.NoError(t, err, "NewFileWithdrawalStore should not return error")
+	lastScanned, err := restored.LastScannedBlock(context.Background())
+	assert.NoError(t, err, "LastScannedBlock should not return error")
+	assert.Equal(t, uint64(10), lastScanned, "Last scanned block should be restored")
+	withdrawals, err := restored.Withdrawals(context.Background())
+	assert.NoError(t, err, "Withdrawals should not return error")
+	assert.Len(t, withdrawals, 1, "Withdrawals should be restored")
+	assert.Equal(t, withdrawal.Hash, withdrawals[0].Hash, "Hash should be restored")
+	assert.Equal(t, withdrawal.Index, withdrawals[0].Index, "Index should be restored")
+	assert.Equal(t, finalizeTxHash, *withdrawals[0].FinalizeTxHash, "Finalization transaction hash should be restored")
+}
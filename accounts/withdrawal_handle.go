@@ -0,0 +1,218 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	"time"
+)
+
+// WithdrawalStatus represents the stage of the withdrawal process.
+type WithdrawalStatus uint8
+
+const (
+	// WithdrawalPending means that the withdrawal transaction is not yet included in the L2 block.
+	WithdrawalPending WithdrawalStatus = iota
+	// WithdrawalIncluded means that the withdrawal transaction is included in the L2 block,
+	// but the batch containing the block is not yet committed to L1.
+	WithdrawalIncluded
+	// WithdrawalCommitted means that the batch containing the withdrawal is committed to L1.
+	WithdrawalCommitted
+	// WithdrawalProven means that the batch containing the withdrawal is proven on L1.
+	WithdrawalProven
+	// WithdrawalFinalizable means that the batch containing the withdrawal is executed on L1,
+	// and the withdrawal can be finalized.
+	WithdrawalFinalizable
+	// WithdrawalFinalized means that the withdrawal is finalized on L1.
+	WithdrawalFinalized
+	// WithdrawalFailed means that the withdrawal transaction failed on L2.
+	WithdrawalFailed
+)
+
+func (s WithdrawalStatus) String() string {
+	switch s {
+	case WithdrawalPending:
+		return "pending"
+	case WithdrawalIncluded:
+		return "included"
+	case WithdrawalCommitted:
+		return "committed"
+	case WithdrawalProven:
+		return "proven"
+	case WithdrawalFinalizable:
+		return "finalizable"
+	case WithdrawalFinalized:
+		return "finalized"
+	case WithdrawalFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// defaultWithdrawalPollInterval is the interval between status checks in WithdrawalHandle.WaitFinalizable.
+const defaultWithdrawalPollInterval = 5 * time.Second
+
+// WithdrawalHandle tracks the withdrawal initiated on L2 network through the stages reported by WithdrawalStatus,
+// and finalizes it on L1 network once the batch containing the withdrawal is executed.
+// It embeds the L2 withdrawal transaction, so it can be used wherever the transaction is expected.
+// The transaction is nil if the withdrawal is sent from SmartAccount, since its EIP-712 transaction
+// cannot be represented by types.Transaction. Use WithdrawalHandle.Hash to identify the withdrawal.
+type WithdrawalHandle struct {
+	*types.Transaction
+	Index int // The index of the withdrawal in the transaction, which is 0 unless the transaction withdraws several times.
+
+	hash         common.Hash
+	client       *clients.Client
+	adapterL1    AdapterL1
+	pollInterval time.Duration
+}
+
+// NewWithdrawalHandle creates a new instance of WithdrawalHandle for the withdrawal transaction.
+// The adapterL1 is optional; if it is not provided, the WithdrawalFinalized status cannot be reported
+// and WithdrawalHandle.Finalize returns an error, until the adapter is set using WithdrawalHandle.ConnectL1.
+func NewWithdrawalHandle(tx *types.Transaction, index int, client *clients.Client, adapterL1 AdapterL1) *WithdrawalHandle {
+	handle := newWithdrawalHandle(tx.Hash(), index, client, adapterL1)
+	handle.Transaction = tx
+	return handle
+}
+
+// newWithdrawalHandle creates a new instance of WithdrawalHandle for the withdrawal transaction with the hash.
+func newWithdrawalHandle(hash common.Hash, index int, client *clients.Client, adapterL1 AdapterL1) *WithdrawalHandle {
+	return &WithdrawalHandle{
+		Index:        index,
+		hash:         hash,
+		client:       client,
+		adapterL1:    adapterL1,
+		pollInterval: defaultWithdrawalPollInterval,
+	}
+}
+
+// Hash returns the hash of the withdrawal transaction.
+func (h *WithdrawalHandle) Hash() common.Hash {
+	return h.hash
+}
+
+// ConnectL1 sets the adapter used to check whether the withdrawal is finalized and to finalize it.
+func (h *WithdrawalHandle) ConnectL1(adapterL1 AdapterL1) *WithdrawalHandle {
+	h.adapterL1 = adapterL1
+	return h
+}
+
+// Status returns the current stage of the withdrawal.
+func (h *WithdrawalHandle) Status(ctx context.Context) (WithdrawalStatus, error) {
+	return withdrawalStatus(ensureContext(ctx), h.client, h.adapterL1, h.Hash(), h.Index)
+}
+
+// WaitFinalizable waits until the withdrawal can be finalized, or is already finalized, and returns its status.
+// It returns an error if the withdrawal transaction failed or the context is done.
+func (h *WithdrawalHandle) WaitFinalizable(ctx context.Context) (WithdrawalStatus, error) {
+	ctx = ensureContext(ctx)
+	interval := h.pollInterval
+	if interval <= 0 {
+		interval = defaultWithdrawalPollInterval
+	}
+	queryTicker := time.NewTicker(interval)
+	defer queryTicker.Stop()
+	for {
+		status, err := h.Status(ctx)
+		if err != nil {
+			return status, err
+		}
+		if status == WithdrawalFailed {
+			return status, errors.New("withdrawal transaction failed")
+		}
+		if status == WithdrawalFinalizable || status == WithdrawalFinalized {
+			return status, nil
+		}
+		// Wait for the next round.
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-queryTicker.C:
+		}
+	}
+}
+
+// Finalize waits until the withdrawal can be finalized and finalizes it on L1 network.
+// If the withdrawal is already finalized, nil transaction is returned.
+func (h *WithdrawalHandle) Finalize(ctx context.Context) (*types.Transaction, error) {
+	return h.finalize(&TransactOpts{Context: ensureContext(ctx)})
+}
+
+// finalize waits until the withdrawal can be finalized and finalizes it using the transaction options.
+func (h *WithdrawalHandle) finalize(auth *TransactOpts) (*types.Transaction, error) {
+	if h.adapterL1 == nil {
+		return nil, errors.New("L1 adapter must be provided to finalize withdrawal")
+	}
+	opts := ensureTransactOpts(auth)
+	status, err := h.WaitFinalizable(opts.Context)
+	if err != nil {
+		return nil, err
+	}
+	if status == WithdrawalFinalized {
+		return nil, nil
+	}
+	return h.adapterL1.FinalizeWithdraw(opts, h.Hash(), h.Index)
+}
+
+// withdrawalStatus returns the current stage of the withdrawal. The adapterL1 is optional; if it is not provided,
+// the WithdrawalFinalized status is never reported.
+func withdrawalStatus(ctx context.Context, client *clients.Client, adapterL1 AdapterL1, hash common.Hash, index int) (WithdrawalStatus, error) {
+	details, err := (*client).TransactionDetails(ctx, hash)
+	if err != nil {
+		return WithdrawalPending, fmt.Errorf("failed to get transaction details: %w", err)
+	}
+	if details == nil || details.Status == "pending" {
+		return WithdrawalPending, nil
+	}
+	if details.Status == "failed" {
+		return WithdrawalFailed, nil
+	}
+
+	receipt, err := (*client).TransactionReceipt(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return WithdrawalPending, nil
+	}
+	if err != nil {
+		return WithdrawalPending, fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		return WithdrawalFailed, nil
+	}
+	if receipt.BlockNumber == nil {
+		return WithdrawalPending, nil
+	}
+	block, err := (*client).BlockDetails(ctx, uint32(receipt.BlockNumber.Uint64()))
+	if err != nil {
+		return WithdrawalIncluded, fmt.Errorf("failed to get block details: %w", err)
+	}
+	if block == nil {
+		return WithdrawalIncluded, nil
+	}
+
+	switch {
+	case block.ExecuteTxHash != nil:
+		if adapterL1 == nil {
+			return WithdrawalFinalizable, nil
+		}
+		finalized, errFinalized := adapterL1.IsWithdrawFinalized(&CallOpts{Context: ctx}, hash, index)
+		if errFinalized != nil {
+			return WithdrawalFinalizable, fmt.Errorf("failed to check if withdrawal is finalized: %w", errFinalized)
+		}
+		if finalized {
+			return WithdrawalFinalized, nil
+		}
+		return WithdrawalFinalizable, nil
+	case block.ProveTxHash != nil:
+		return WithdrawalProven, nil
+	case block.CommitTxHash != nil:
+		return WithdrawalCommitted, nil
+	default:
+		return WithdrawalIncluded, nil
+	}
+}
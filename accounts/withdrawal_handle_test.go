@@ -0,0 +1,183 @@
+package accounts
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"github.com/zksync-sdk/zksync2-go/zksynctest"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// withdrawalTestClient is the stand-in for the client which serves the details of the withdrawal.
+type withdrawalTestClient struct {
+	zkSyncClient
+	details *zkTypes.TransactionDetails
+	block   *zkTypes.BlockDetails
+}
+
+func (c *withdrawalTestClient) TransactionDetails(_ context.Context, _ common.Hash) (*zkTypes.TransactionDetails, error) {
+	return c.details, nil
+}
+
+func (c *withdrawalTestClient) TransactionReceipt(_ context.Context, _ common.Hash) (*zkTypes.Receipt, error) {
+	return &zkTypes.Receipt{Receipt: types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(10)}}, nil
+}
+
+func (c *withdrawalTestClient) BlockDetails(_ context.Context, _ uint32) (*zkTypes.BlockDetails, error) {
+	return c.block, nil
+}
+
+// withdrawalTestAdapterL1 is the stand-in for the L1 adapter which finalizes the withdrawal.
+type withdrawalTestAdapterL1 struct {
+	AdapterL1
+	finalized bool
+	finalizes int
+	auth      *TransactOpts
+}
+
+func (a *withdrawalTestAdapterL1) IsWithdrawFinalized(_ *CallOpts, _ common.Hash, _ int) (bool, error) {
+	return a.finalized, nil
+}
+
+func (a *withdrawalTestAdapterL1) FinalizeWithdraw(auth *TransactOpts, _ common.Hash, _ int) (*types.Transaction, error) {
+	a.auth = auth
+	a.finalizes++
+	a.finalized = true
+	return types.NewTx(&types.DynamicFeeTx{}), nil
+}
+
+func TestWithdrawalHandleStatus(t *testing.T) {
+	hash := common.HexToHash("0x01")
+	client := &withdrawalTestClient{details: &zkTypes.TransactionDetails{Status: "pending"}, block: &zkTypes.BlockDetails{}}
+	adapterL1 := &withdrawalTestAdapterL1{}
+	handle := NewWithdrawalHandle(types.NewTx(&types.DynamicFeeTx{}), 0, toClient(client), adapterL1)
+
+	status, err := handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, WithdrawalPending, status, "Withdrawal should be pending")
+
+	client.details.Status = "included"
+	status, err = handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, WithdrawalIncluded, status, "Withdrawal should be included")
+
+	client.block.CommitTxHash = &hash
+	status, err = handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, WithdrawalCommitted, status, "Withdrawal should be committed")
+
+	client.block.ProveTxHash = &hash
+	status, err = handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, WithdrawalProven, status, "Withdrawal should be proven")
+
+	client.details.Status = "verified"
+	client.block.ExecuteTxHash = &hash
+	status, err = handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, WithdrawalFinalizable, status, "Withdrawal should be finalizable")
+
+	adapterL1.finalized = true
+	status, err = handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, WithdrawalFinalized, status, "Withdrawal should be finalized")
+
+	client.details.Status = "failed"
+	status, err = handle.Status(context.Background())
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, WithdrawalFailed, status, "Withdrawal should be failed")
+}
+
+func TestWithdrawalHandleWaitFinalizable(t *testing.T) {
+	client := &withdrawalTestClient{details: &zkTypes.TransactionDetails{Status: "included"}, block: &zkTypes.BlockDetails{}}
+	handle := NewWithdrawalHandle(types.NewTx(&types.DynamicFeeTx{}), 0, toClient(client), nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	handle.pollInterval = 10 * time.Millisecond
+	status, err := handle.WaitFinalizable(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "WaitFinalizable should return error when context is done")
+	assert.Equal(t, WithdrawalIncluded, status, "Withdrawal should be included")
+
+	client.details.Status = "failed"
+	_, err = handle.WaitFinalizable(context.Background())
+	assert.Error(t, err, "WaitFinalizable should return error for failed withdrawal")
+}
+
+func TestWithdrawalHandleFinalize(t *testing.T) {
+	hash := common.HexToHash("0x01")
+	client := &withdrawalTestClient{
+		details: &zkTypes.TransactionDetails{Status: "verified"},
+		block:   &zkTypes.BlockDetails{CommitTxHash: &hash, ProveTxHash: &hash, ExecuteTxHash: &hash},
+	}
+	handle := NewWithdrawalHandle(types.NewTx(&types.DynamicFeeTx{}), 0, toClient(client), nil)
+
+	_, err := handle.Finalize(context.Background())
+	assert.Error(t, err, "Finalize should return error without L1 adapter")
+
+	adapterL1 := &withdrawalTestAdapterL1{}
+	handle.ConnectL1(adapterL1)
+	finalizeTx, err := handle.Finalize(context.Background())
+	assert.NoError(t, err, "Finalize should not return error")
+	assert.NotNil(t, finalizeTx, "Finalize should return transaction")
+
+	finalizeTx, err = handle.Finalize(context.Background())
+	assert.NoError(t, err, "Finalize should not return error")
+	assert.Nil(t, finalizeTx, "Finalize should not send transaction for finalized withdrawal")
+	assert.Equal(t, 1, adapterL1.finalizes, "Withdrawal should be finalized once")
+}
+
+func TestWithdrawalOnFakeNode(t *testing.T) {
+	ctx := context.Background()
+	node := zksynctest.NewNode(zksynctest.Config{})
+	defer node.Close()
+	client := node.Client()
+	privateKey := common.Hex2Bytes("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110")
+	walletL2, err := NewWalletL2(privateKey, &client)
+	assert.NoError(t, err, "NewWalletL2 should not return error")
+	walletL1, err := NewWalletL1(privateKey, node.L1Client(), &client)
+	assert.NoError(t, err, "NewWalletL1 should not return error")
+	receiver := common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618")
+	amount := big.NewInt(1_000_000)
+	node.SetBalance(walletL2.Address(), big.NewInt(1_000_000_000_000_000_000))
+
+	handle, err := walletL2.Withdraw(&TransactOpts{Value: amount}, WithdrawalTransaction{To: receiver, Amount: amount, Token: utils.EthAddress})
+	assert.NoError(t, err, "Withdraw should not return error")
+	handle.ConnectL1(walletL1)
+	status, err := handle.Status(ctx)
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, WithdrawalIncluded, status, "Withdrawal should be included")
+
+	batchNumber := node.SealBatch()
+	assert.NoError(t, node.CommitBatch(batchNumber), "CommitBatch should not return error")
+	status, err = handle.Status(ctx)
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, WithdrawalCommitted, status, "Withdrawal should be committed")
+	assert.NoError(t, node.ProveBatch(batchNumber), "ProveBatch should not return error")
+	status, err = handle.Status(ctx)
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, WithdrawalProven, status, "Withdrawal should be proven")
+	assert.NoError(t, node.ExecuteBatch(batchNumber), "ExecuteBatch should not return error")
+	status, err = handle.Status(ctx)
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, WithdrawalFinalizable, status, "Withdrawal should be finalizable")
+
+	proof, err := walletL1.VerifyL2MessageProof(nil, handle.Hash(), 0)
+	assert.NoError(t, err, "VerifyL2MessageProof should not return error")
+	assert.Equal(t, new(big.Int).SetUint64(batchNumber), proof.L2BatchNumber, "Batch numbers should be the same")
+
+	_, err = walletL1.FinalizeWithdraw(nil, handle.Hash(), 0)
+	assert.NoError(t, err, "FinalizeWithdraw should not return error")
+	status, err = handle.Status(ctx)
+	assert.NoError(t, err, "Status should not return error")
+	assert.Equal(t, WithdrawalFinalized, status, "Withdrawal should be finalized")
+	assert.Equal(t, amount, node.L1Balance(receiver), "Balances should be the same")
+
+	_, err = walletL1.FinalizeWithdraw(nil, handle.Hash(), 0)
+	assert.Error(t, err, "FinalizeWithdraw should return error for finalized withdrawal")
+}
@@ -0,0 +1,192 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TrackedWithdrawal is the withdrawal tracked by the WithdrawalFinalizer until it is finalized on L1 network.
+type TrackedWithdrawal struct {
+	Hash           common.Hash    `json:"hash"`                     // The hash of the L2 withdrawal transaction.
+	Index          int            `json:"index"`                    // The index of the withdrawal in the transaction.
+	From           common.Address `json:"from"`                     // The account that initiated the withdrawal.
+	BlockNumber    uint64         `json:"blockNumber"`              // The L2 block containing the withdrawal transaction.
+	FinalizeTxHash *common.Hash   `json:"finalizeTxHash,omitempty"` // The hash of the last sent L1 finalization transaction.
+	FinalizeSentAt time.Time      `json:"finalizeSentAt"`           // The time when the last finalization transaction was sent.
+}
+
+// WithdrawalStore persists the progress of the WithdrawalFinalizer, so the finalization can be resumed
+// after restart. Implementations must be safe for concurrent use.
+type WithdrawalStore interface {
+	// LastScannedBlock returns the last L2 block scanned for withdrawals, or 0 if no block is scanned yet.
+	LastScannedBlock(ctx context.Context) (uint64, error)
+	// SetLastScannedBlock stores the last L2 block scanned for withdrawals.
+	SetLastScannedBlock(ctx context.Context, block uint64) error
+	// Withdrawals returns the tracked withdrawals that are not finalized yet.
+	Withdrawals(ctx context.Context) ([]TrackedWithdrawal, error)
+	// PutWithdrawal adds the withdrawal or replaces the one with the same hash and index.
+	PutWithdrawal(ctx context.Context, withdrawal TrackedWithdrawal) error
+	// DeleteWithdrawal removes the withdrawal, which is done once it is finalized.
+	DeleteWithdrawal(ctx context.Context, hash common.Hash, index int) error
+}
+
+type withdrawalKey struct {
+	Hash  common.Hash
+	Index int
+}
+
+// withdrawalState is the progress of the WithdrawalFinalizer kept by the built-in stores.
+type withdrawalState struct {
+	LastScannedBlock uint64              `json:"lastScannedBlock"`
+	Withdrawals      []TrackedWithdrawal `json:"withdrawals"`
+}
+
+// MemoryWithdrawalStore is a WithdrawalStore that keeps the progress in memory. It does not survive restarts,
+// and is intended for testing and for services that rescan the blocks on every start.
+type MemoryWithdrawalStore struct {
+	mu               sync.Mutex
+	lastScannedBlock uint64
+	withdrawals      map[withdrawalKey]TrackedWithdrawal
+}
+
+// NewMemoryWithdrawalStore creates a new instance of MemoryWithdrawalStore.
+func NewMemoryWithdrawalStore() *MemoryWithdrawalStore {
+	return &MemoryWithdrawalStore{withdrawals: make(map[withdrawalKey]TrackedWithdrawal)}
+}
+
+func (s *MemoryWithdrawalStore) LastScannedBlock(_ context.Context) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastScannedBlock, nil
+}
+
+func (s *MemoryWithdrawalStore) SetLastScannedBlock(_ context.Context, block uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastScannedBlock = block
+	return nil
+}
+
+func (s *MemoryWithdrawalStore) Withdrawals(_ context.Context) ([]TrackedWithdrawal, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sortedWithdrawals(), nil
+}
+
+func (s *MemoryWithdrawalStore) PutWithdrawal(_ context.Context, withdrawal TrackedWithdrawal) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.withdrawals[withdrawalKey{withdrawal.Hash, withdrawal.Index}] = withdrawal
+	return nil
+}
+
+func (s *MemoryWithdrawalStore) DeleteWithdrawal(_ context.Context, hash common.Hash, index int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.withdrawals, withdrawalKey{hash, index})
+	return nil
+}
+
+func (s *MemoryWithdrawalStore) sortedWithdrawals() []TrackedWithdrawal {
+	withdrawals := make([]TrackedWithdrawal, 0, len(s.withdrawals))
+	for _, withdrawal := range s.withdrawals {
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	sort.Slice(withdrawals, func(i, j int) bool {
+		if withdrawals[i].BlockNumber != withdrawals[j].BlockNumber {
+			return withdrawals[i].BlockNumber < withdrawals[j].BlockNumber
+		}
+		if withdrawals[i].Hash != withdrawals[j].Hash {
+			return withdrawals[i].Hash.Big().Cmp(withdrawals[j].Hash.Big()) < 0
+		}
+		return withdrawals[i].Index < withdrawals[j].Index
+	})
+	return withdrawals
+}
+
+// FileWithdrawalStore is a WithdrawalStore that keeps the progress in the JSON file. The file is rewritten
+// atomically on every change, so the progress is not lost if the process is terminated.
+type FileWithdrawalStore struct {
+	MemoryWithdrawalStore
+	path string
+}
+
+// NewFileWithdrawalStore creates a new instance of FileWithdrawalStore that loads the progress from the file
+// at the given path, if the file exists.
+func NewFileWithdrawalStore(path string) (*FileWithdrawalStore, error) {
+	store := &FileWithdrawalStore{
+		MemoryWithdrawalStore: *NewMemoryWithdrawalStore(),
+		path:                  path,
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read withdrawal store: %w", err)
+	}
+	var state withdrawalState
+	if err = json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode withdrawal store: %w", err)
+	}
+	store.lastScannedBlock = state.LastScannedBlock
+	for _, withdrawal := range state.Withdrawals {
+		store.withdrawals[withdrawalKey{withdrawal.Hash, withdrawal.Index}] = withdrawal
+	}
+	return store, nil
+}
+
+func (s *FileWithdrawalStore) SetLastScannedBlock(_ context.Context, block uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastScannedBlock = block
+	return s.save()
+}
+
+func (s *FileWithdrawalStore) PutWithdrawal(_ context.Context, withdrawal TrackedWithdrawal) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.withdrawals[withdrawalKey{withdrawal.Hash, withdrawal.Index}] = withdrawal
+	return s.save()
+}
+
+func (s *FileWithdrawalStore) DeleteWithdrawal(_ context.Context, hash common.Hash, index int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.withdrawals, withdrawalKey{hash, index})
+	return s.save()
+}
+
+func (s *FileWithdrawalStore) save() error {
+	data, err := json.MarshalIndent(withdrawalState{
+		LastScannedBlock: s.lastScannedBlock,
+		Withdrawals:      s.sortedWithdrawals(),
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode withdrawal store: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write withdrawal store: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write withdrawal store: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write withdrawal store: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to write withdrawal store: %w", err)
+	}
+	return nil
+}
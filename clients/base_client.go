@@ -61,6 +61,10 @@ func (c *BaseClient) Close() {
 	c.ethClient.Close()
 }
 
+func (c *BaseClient) NewBatch() *Batch {
+	return NewBatch(c.rpcClient)
+}
+
 func (c *BaseClient) ChainID(ctx context.Context) (*big.Int, error) {
 	return c.ethClient.ChainID(ctx)
 }
@@ -391,6 +395,48 @@ func (c *BaseClient) BridgeContracts(ctx context.Context) (*zkTypes.BridgeContra
 	return &res, nil
 }
 
+func (c *BaseClient) BridgehubContractAddress(ctx context.Context) (common.Address, error) {
+	var res common.Address
+	err := c.rpcClient.CallContext(ctx, &res, "zks_getBridgehubContract")
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to query zks_getBridgehubContract: %w", err)
+	}
+	return res, nil
+}
+
+func (c *BaseClient) BaseTokenContractAddress(ctx context.Context) (common.Address, error) {
+	var res common.Address
+	err := c.rpcClient.CallContext(ctx, &res, "zks_getBaseTokenL1Address")
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to query zks_getBaseTokenL1Address: %w", err)
+	}
+	return res, nil
+}
+
+func (c *BaseClient) IsEthBasedChain(ctx context.Context) (bool, error) {
+	baseToken, err := c.BaseTokenContractAddress(ctx)
+	if isMethodNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return baseToken == utils.EthAddressInContracts, nil
+}
+
+func (c *BaseClient) IsBaseToken(ctx context.Context, token common.Address) (bool, error) {
+	baseToken, err := c.BaseTokenContractAddress(ctx)
+	if isMethodNotFound(err) {
+		baseToken = utils.EthAddressInContracts
+	} else if err != nil {
+		return false, err
+	}
+	if token == utils.EthAddress {
+		token = utils.EthAddressInContracts
+	}
+	return token == baseToken, nil
+}
+
 func (c *BaseClient) ContractAccountInfo(ctx context.Context, address common.Address) (*zkTypes.ContractAccountInfo, error) {
 	contractDeployer, err := contractdeployer.NewIContractDeployerCaller(utils.ContractDeployerAddress, c)
 	if err != nil {
@@ -548,8 +594,22 @@ func (c *BaseClient) TokenPrice(ctx context.Context, address common.Address) (*b
 }
 
 func (c *BaseClient) L2TokenAddress(ctx context.Context, token common.Address) (common.Address, error) {
-	if token == utils.EthAddress {
-		return utils.EthAddress, nil
+	if token == utils.EthAddress || token == utils.EthAddressInContracts {
+		isEthBasedChain, err := c.IsEthBasedChain(ctx)
+		if err != nil {
+			return common.Address{}, err
+		}
+		if isEthBasedChain {
+			return utils.EthAddress, nil
+		}
+		token = utils.EthAddressInContracts
+	}
+	isBaseToken, err := c.IsBaseToken(ctx, token)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if isBaseToken {
+		return utils.L2BaseTokenAddress, nil
 	} else {
 		bridgeContracts, err := c.BridgeContracts(ctx)
 		if err != nil {
@@ -570,6 +630,15 @@ func (c *BaseClient) L2TokenAddress(ctx context.Context, token common.Address) (
 func (c *BaseClient) L1TokenAddress(ctx context.Context, token common.Address) (common.Address, error) {
 	if token == utils.EthAddress {
 		return utils.EthAddress, nil
+	} else if token == utils.L2BaseTokenAddress {
+		baseToken, err := c.BaseTokenContractAddress(ctx)
+		if isMethodNotFound(err) || baseToken == utils.EthAddressInContracts {
+			return utils.EthAddress, nil
+		}
+		if err != nil {
+			return common.Address{}, err
+		}
+		return baseToken, nil
 	} else {
 		bridgeContracts, err := c.BridgeContracts(ctx)
 		if err != nil {
@@ -635,12 +704,27 @@ func (c *BaseClient) EstimateGasWithdraw(ctx context.Context, msg WithdrawalCall
 		err     error
 	)
 
+	msg.Token, err = ResolveL2Token(ctx, c, msg.Token)
+	if err != nil {
+		return 0, err
+	}
 	if msg.BridgeAddress == nil {
 		contracts, errBridge := c.BridgeContracts(ctx)
 		if errBridge != nil {
 			return 0, fmt.Errorf("failed to getBridgeContracts: %w", errBridge)
 		}
-		callMsg, err = msg.ToCallMsg(&contracts.L2Erc20DefaultBridge)
+		bridge := contracts.L2Erc20DefaultBridge
+		isWeth, errWeth := utils.IsL2WethToken(ctx, c, contracts.L2WethBridge, msg.Token)
+		if errWeth != nil {
+			return 0, errWeth
+		}
+		if isWeth {
+			bridge = contracts.L2WethBridge
+		}
+		callMsg, err = msg.ToCallMsg(&bridge)
+		if err != nil {
+			return 0, err
+		}
 	} else {
 		callMsg, err = msg.ToCallMsg(nil)
 		if err != nil {
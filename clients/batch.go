@@ -0,0 +1,209 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"math/big"
+)
+
+// DefaultMaxBatchSize is the maximum number of requests sent in a single JSON-RPC batch
+// unless configured otherwise with Batch.SetMaxSize.
+const DefaultMaxBatchSize = 100
+
+// ErrBatchNotSent is returned by the result of a batch request when the batch has not been sent yet.
+var ErrBatchNotSent = errors.New("batch request has not been sent")
+
+// Batch queues JSON-RPC requests and sends them to the node using JSON-RPC batches, saving
+// a round trip for each request. Requests are split into multiple batches when their number
+// exceeds the maximum batch size. Each queued request returns a handle from which the result
+// can be read once the batch is sent.
+//
+// Batch is not safe for concurrent use.
+type Batch struct {
+	rpcClient *rpc.Client
+	maxSize   int
+	elems     []rpc.BatchElem
+	finishers []func(err error)
+}
+
+// NewBatch creates a batch that sends requests using the given RPC client.
+func NewBatch(c *rpc.Client) *Batch {
+	return &Batch{
+		rpcClient: c,
+		maxSize:   DefaultMaxBatchSize,
+	}
+}
+
+// SetMaxSize sets the maximum number of requests sent in a single JSON-RPC batch.
+// Non-positive size disables splitting, so all requests are sent in a single batch.
+func (b *Batch) SetMaxSize(size int) *Batch {
+	b.maxSize = size
+	return b
+}
+
+// Len returns the number of queued requests.
+func (b *Batch) Len() int {
+	return len(b.elems)
+}
+
+// Send sends all queued requests and populates their results. Errors of the individual requests
+// are reported by their handles, while the returned error indicates that the batch could not be
+// sent. In that case, the requests which are not sent report the same error. The batch is emptied
+// afterward, so it can be reused for new requests.
+func (b *Batch) Send(ctx context.Context) error {
+	elems, finishers := b.elems, b.finishers
+	b.elems, b.finishers = nil, nil
+
+	size := b.maxSize
+	if size <= 0 {
+		size = len(elems)
+	}
+	for start := 0; start < len(elems); start += size {
+		end := start + size
+		if end > len(elems) {
+			end = len(elems)
+		}
+		if err := b.rpcClient.BatchCallContext(ctx, elems[start:end]); err != nil {
+			err = fmt.Errorf("failed to send batch: %w", err)
+			for _, finish := range finishers[start:] {
+				finish(err)
+			}
+			return err
+		}
+		for i := start; i < end; i++ {
+			finishers[i](elems[i].Error)
+		}
+	}
+	return nil
+}
+
+// BatchRequest is the handle of the request queued in the Batch.
+type BatchRequest[T any] struct {
+	method string
+	raw    json.RawMessage
+	decode func(raw json.RawMessage) (T, error)
+	result T
+	err    error
+}
+
+// Result returns the result of the request. It returns ethereum.NotFound if the node responded
+// with null result and ErrBatchNotSent if the batch has not been sent yet.
+func (r *BatchRequest[T]) Result() (T, error) {
+	return r.result, r.err
+}
+
+func (r *BatchRequest[T]) finish(err error) {
+	r.err = nil
+	if err != nil {
+		r.err = fmt.Errorf("failed to query %s: %w", r.method, err)
+		return
+	}
+	if len(r.raw) == 0 || string(r.raw) == "null" {
+		r.err = ethereum.NotFound
+		return
+	}
+	if r.result, err = r.decode(r.raw); err != nil {
+		r.err = fmt.Errorf("failed to decode %s result: %w", r.method, err)
+	}
+}
+
+// BatchCall queues the call of an arbitrary JSON-RPC method, whose result is decoded into the value
+// of type T.
+func BatchCall[T any](b *Batch, method string, args ...interface{}) *BatchRequest[T] {
+	return batchCall(b, func(raw json.RawMessage) (T, error) {
+		var result T
+		err := json.Unmarshal(raw, &result)
+		return result, err
+	}, method, args...)
+}
+
+func batchCall[T any](b *Batch, decode func(raw json.RawMessage) (T, error), method string, args ...interface{}) *BatchRequest[T] {
+	req := &BatchRequest[T]{
+		method: method,
+		decode: decode,
+		err:    ErrBatchNotSent,
+	}
+	b.elems = append(b.elems, rpc.BatchElem{
+		Method: method,
+		Args:   args,
+		Result: &req.raw,
+	})
+	b.finishers = append(b.finishers, req.finish)
+	return req
+}
+
+// BlockNumber queues the request for the most recent block number.
+func (b *Batch) BlockNumber() *BatchRequest[uint64] {
+	return batchCall(b, func(raw json.RawMessage) (uint64, error) {
+		var result hexutil.Uint64
+		err := json.Unmarshal(raw, &result)
+		return uint64(result), err
+	}, "eth_blockNumber")
+}
+
+// BlockByNumber queues the request for the block from the current canonical chain. If number is nil,
+// the latest known block is requested.
+func (b *Batch) BlockByNumber(number *big.Int) *BatchRequest[*zkTypes.Block] {
+	return BatchCall[*zkTypes.Block](b, "eth_getBlockByNumber", toBlockNumArg(number), true)
+}
+
+// BlockByHash queues the request for the given full block.
+func (b *Batch) BlockByHash(hash common.Hash) *BatchRequest[*zkTypes.Block] {
+	return BatchCall[*zkTypes.Block](b, "eth_getBlockByHash", hash, true)
+}
+
+// BalanceAt queues the request for the wei balance of the given account.
+// The block number can be nil, in which case the balance is taken from the latest known block.
+func (b *Batch) BalanceAt(account common.Address, blockNumber *big.Int) *BatchRequest[*big.Int] {
+	return batchCall(b, func(raw json.RawMessage) (*big.Int, error) {
+		var result hexutil.Big
+		err := json.Unmarshal(raw, &result)
+		return (*big.Int)(&result), err
+	}, "eth_getBalance", account, toBlockNumArg(blockNumber))
+}
+
+// TransactionByHash queues the request for the transaction with the given hash.
+func (b *Batch) TransactionByHash(hash common.Hash) *BatchRequest[*zkTypes.TransactionResponse] {
+	return BatchCall[*zkTypes.TransactionResponse](b, "eth_getTransactionByHash", hash)
+}
+
+// TransactionReceipt queues the request for the receipt of a transaction by transaction hash.
+func (b *Batch) TransactionReceipt(txHash common.Hash) *BatchRequest[*zkTypes.Receipt] {
+	return BatchCall[*zkTypes.Receipt](b, "eth_getTransactionReceipt", txHash)
+}
+
+// L1BatchNumber queues the request for the latest L1 batch number.
+func (b *Batch) L1BatchNumber() *BatchRequest[*big.Int] {
+	return batchCall(b, func(raw json.RawMessage) (*big.Int, error) {
+		var result hexutil.Big
+		err := json.Unmarshal(raw, &result)
+		return (*big.Int)(&result), err
+	}, "zks_L1BatchNumber")
+}
+
+// L1BatchDetails queues the request for data pertaining to a given batch.
+func (b *Batch) L1BatchDetails(l1BatchNumber *big.Int) *BatchRequest[*zkTypes.BatchDetails] {
+	return BatchCall[*zkTypes.BatchDetails](b, "zks_getL1BatchDetails", l1BatchNumber)
+}
+
+// BlockDetails queues the request for additional zkSync-specific information about the L2 block.
+func (b *Batch) BlockDetails(block uint32) *BatchRequest[*zkTypes.BlockDetails] {
+	return BatchCall[*zkTypes.BlockDetails](b, "zks_getBlockDetails", block)
+}
+
+// TransactionDetails queues the request for data from a specific transaction given by the transaction hash.
+func (b *Batch) TransactionDetails(txHash common.Hash) *BatchRequest[*zkTypes.TransactionDetails] {
+	return BatchCall[*zkTypes.TransactionDetails](b, "zks_getTransactionDetails", txHash)
+}
+
+// LogProof queues the request for the proof for the corresponding L2 to L1 log.
+func (b *Batch) LogProof(txHash common.Hash, logIndex int) *BatchRequest[*zkTypes.MessageProof] {
+	return BatchCall[*zkTypes.MessageProof](b, "zks_getL2ToL1LogProof", txHash, logIndex)
+}
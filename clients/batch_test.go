@@ -0,0 +1,89 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"testing"
+)
+
+// batchTestZksService serves zks_getBlockDetails for blocks up to the latest one.
+type batchTestZksService struct {
+	latest uint32
+}
+
+func (s *batchTestZksService) GetBlockDetails(block uint32) (*zkTypes.BlockDetails, error) {
+	if block > s.latest {
+		return nil, nil
+	}
+	return &zkTypes.BlockDetails{Number: uint(block), Status: "sealed"}, nil
+}
+
+// batchTestEthService serves eth_blockNumber.
+type batchTestEthService struct {
+	latest uint32
+}
+
+func (s *batchTestEthService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.latest)
+}
+
+func newBatchTestClient(t *testing.T, batchItemLimit int) Client {
+	server := rpc.NewServer()
+	assert.NoError(t, server.RegisterName("zks", &batchTestZksService{latest: 4}), "RegisterName should not return error")
+	assert.NoError(t, server.RegisterName("eth", &batchTestEthService{latest: 4}), "RegisterName should not return error")
+	server.SetBatchLimits(batchItemLimit, 0)
+	t.Cleanup(server.Stop)
+	return NewClient(rpc.DialInProc(server))
+}
+
+func TestBatch(t *testing.T) {
+	client := newBatchTestClient(t, 2)
+	batch := client.NewBatch().SetMaxSize(2)
+
+	blockNumber := batch.BlockNumber()
+	details := make([]*BatchRequest[*zkTypes.BlockDetails], 6)
+	for i := range details {
+		details[i] = batch.BlockDetails(uint32(i))
+	}
+	unknown := BatchCall[string](batch, "zks_unknownMethod")
+	assert.Equal(t, 8, batch.Len(), "All requests should be queued")
+
+	_, err := details[0].Result()
+	assert.ErrorIs(t, err, ErrBatchNotSent, "Result should return error before the batch is sent")
+
+	err = batch.Send(context.Background())
+	assert.NoError(t, err, "Send should not return error")
+	assert.Equal(t, 0, batch.Len(), "Batch should be emptied after it is sent")
+
+	number, err := blockNumber.Result()
+	assert.NoError(t, err, "BlockNumber should not return error")
+	assert.Equal(t, uint64(4), number, "Block numbers should be the same")
+	for i, req := range details[:5] {
+		block, errDetails := req.Result()
+		assert.NoError(t, errDetails, "BlockDetails should not return error")
+		assert.Equal(t, uint(i), block.Number, "Block numbers should be the same")
+	}
+	_, err = details[5].Result()
+	assert.ErrorIs(t, err, ethereum.NotFound, "BlockDetails should return NotFound for unknown block")
+	_, err = unknown.Result()
+	var rpcErr rpc.Error
+	assert.True(t, errors.As(err, &rpcErr), "Call of unknown method should return RPC error")
+}
+
+func TestBatchExceedingLimit(t *testing.T) {
+	client := newBatchTestClient(t, 2)
+	batch := client.NewBatch().SetMaxSize(0)
+
+	requests := []*BatchRequest[*zkTypes.BlockDetails]{batch.BlockDetails(1), batch.BlockDetails(2), batch.BlockDetails(3)}
+	err := batch.Send(context.Background())
+	assert.NoError(t, err, "Send should not return error")
+	for _, req := range requests {
+		_, errDetails := req.Result()
+		assert.Error(t, errDetails, "BlockDetails should return error when batch exceeds the limit of the node")
+	}
+}
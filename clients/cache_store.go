@@ -0,0 +1,132 @@
+package clients
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CacheStore stores the responses cached by the CachingClient. Implementations must be safe
+// for concurrent use.
+type CacheStore interface {
+	// Get returns the value stored under the key and whether the value is found.
+	Get(key string) ([]byte, bool, error)
+	// Set stores the value under the key.
+	Set(key string, value []byte) error
+}
+
+// LRUCacheStore is the in-memory CacheStore which keeps up to the given number of values,
+// evicting the least recently used ones.
+type LRUCacheStore struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+// NewLRUCacheStore creates the in-memory store which keeps up to size values.
+// If the size is not positive, the store is unbounded and never evicts values.
+func NewLRUCacheStore(size int) *LRUCacheStore {
+	return &LRUCacheStore{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (s *LRUCacheStore) Get(key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	elem, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	s.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry).value, true, nil
+}
+
+func (s *LRUCacheStore) Set(key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if elem, ok := s.entries[key]; ok {
+		elem.Value.(*lruEntry).value = value
+		s.order.MoveToFront(elem)
+		return nil
+	}
+	s.entries[key] = s.order.PushFront(&lruEntry{key: key, value: value})
+	for s.size > 0 && s.order.Len() > s.size {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.entries, oldest.Value.(*lruEntry).key)
+	}
+	return nil
+}
+
+// Len returns the number of stored values.
+func (s *LRUCacheStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.order.Len()
+}
+
+// DiskCacheStore is the CacheStore which keeps each value in a separate file within the directory,
+// so that the cache survives restarts and can be shared by several processes.
+type DiskCacheStore struct {
+	dir string
+}
+
+// NewDiskCacheStore creates the store which keeps the values in the given directory, creating it
+// if it does not exist.
+func NewDiskCacheStore(dir string) (*DiskCacheStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &DiskCacheStore{dir: dir}, nil
+}
+
+func (s *DiskCacheStore) Get(key string) ([]byte, bool, error) {
+	value, err := os.ReadFile(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, fmt.Errorf("failed to read cache entry: %w", err)
+	}
+	return value, true, nil
+}
+
+// Set writes the value into a temporary file first and renames it afterward, so that readers
+// never observe partially written values.
+func (s *DiskCacheStore) Set(key string, value []byte) error {
+	f, err := os.CreateTemp(s.dir, "tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create cache entry: %w", err)
+	}
+	_, err = f.Write(value)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), s.path(key))
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	return nil
+}
+
+func (s *DiskCacheStore) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(hash[:]))
+}
@@ -0,0 +1,148 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"math/big"
+)
+
+// cachedClient is the alias which allows CachingClient to embed the Client without the name
+// of the embedded field clashing with the Client method.
+type cachedClient = Client
+
+// CachingClient is the Client decorator which caches the responses that can no longer change.
+// The following responses are cached:
+//   - MainContractAddress, BridgeContracts, BaseTokenContractAddress and L1ChainID, which are fixed for the chain.
+//   - L1TokenAddress and L2TokenAddress, which map tokens deterministically.
+//   - L1BatchDetails and BlockDetails once their status is verified, that is, the batch is executed on L1.
+//   - BlockByHash and TransactionReceipt once the batch that includes them is verified.
+//
+// Other methods are forwarded to the decorated client. The cache is best-effort: errors of the store
+// are ignored and the response is fetched from the decorated client instead. The keys include the
+// chain ID, so that a single store can be shared by clients of different chains.
+type CachingClient struct {
+	cachedClient
+	store   CacheStore
+	chainID *big.Int
+}
+
+// NewCachingClient creates the client which caches the responses of the given client in the store.
+func NewCachingClient(ctx context.Context, client Client, store CacheStore) (*CachingClient, error) {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	return &CachingClient{
+		cachedClient: client,
+		store:        store,
+		chainID:      chainID,
+	}, nil
+}
+
+func (c *CachingClient) MainContractAddress(ctx context.Context) (common.Address, error) {
+	return cached(c, "MainContractAddress", func() (common.Address, error) {
+		return c.cachedClient.MainContractAddress(ctx)
+	}, isNonZeroAddress)
+}
+
+func (c *CachingClient) BridgeContracts(ctx context.Context) (*zkTypes.BridgeContracts, error) {
+	return cached(c, "BridgeContracts", func() (*zkTypes.BridgeContracts, error) {
+		return c.cachedClient.BridgeContracts(ctx)
+	}, func(contracts *zkTypes.BridgeContracts) bool { return contracts != nil })
+}
+
+func (c *CachingClient) BaseTokenContractAddress(ctx context.Context) (common.Address, error) {
+	return cached(c, "BaseTokenContractAddress", func() (common.Address, error) {
+		return c.cachedClient.BaseTokenContractAddress(ctx)
+	}, isNonZeroAddress)
+}
+
+func (c *CachingClient) L1ChainID(ctx context.Context) (*big.Int, error) {
+	return cached(c, "L1ChainID", func() (*big.Int, error) {
+		return c.cachedClient.L1ChainID(ctx)
+	}, func(chainID *big.Int) bool { return chainID != nil })
+}
+
+func (c *CachingClient) L1TokenAddress(ctx context.Context, token common.Address) (common.Address, error) {
+	return cached(c, "L1TokenAddress/"+token.Hex(), func() (common.Address, error) {
+		return c.cachedClient.L1TokenAddress(ctx, token)
+	}, isNonZeroAddress)
+}
+
+func (c *CachingClient) L2TokenAddress(ctx context.Context, token common.Address) (common.Address, error) {
+	return cached(c, "L2TokenAddress/"+token.Hex(), func() (common.Address, error) {
+		return c.cachedClient.L2TokenAddress(ctx, token)
+	}, isNonZeroAddress)
+}
+
+func (c *CachingClient) L1BatchDetails(ctx context.Context, l1BatchNumber *big.Int) (*zkTypes.BatchDetails, error) {
+	if l1BatchNumber == nil {
+		return c.cachedClient.L1BatchDetails(ctx, l1BatchNumber)
+	}
+	return cached(c, "L1BatchDetails/"+l1BatchNumber.String(), func() (*zkTypes.BatchDetails, error) {
+		return c.cachedClient.L1BatchDetails(ctx, l1BatchNumber)
+	}, func(details *zkTypes.BatchDetails) bool { return details != nil && isFinalStatus(details.Status) })
+}
+
+func (c *CachingClient) BlockDetails(ctx context.Context, block uint32) (*zkTypes.BlockDetails, error) {
+	return cached(c, fmt.Sprintf("BlockDetails/%d", block), func() (*zkTypes.BlockDetails, error) {
+		return c.cachedClient.BlockDetails(ctx, block)
+	}, func(details *zkTypes.BlockDetails) bool { return details != nil && isFinalStatus(details.Status) })
+}
+
+func (c *CachingClient) BlockByHash(ctx context.Context, hash common.Hash) (*zkTypes.Block, error) {
+	return cached(c, "BlockByHash/"+hash.Hex(), func() (*zkTypes.Block, error) {
+		return c.cachedClient.BlockByHash(ctx, hash)
+	}, func(block *zkTypes.Block) bool { return block != nil && c.isBatchFinal(ctx, block.L1BatchNumber) })
+}
+
+func (c *CachingClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*zkTypes.Receipt, error) {
+	return cached(c, "TransactionReceipt/"+txHash.Hex(), func() (*zkTypes.Receipt, error) {
+		return c.cachedClient.TransactionReceipt(ctx, txHash)
+	}, func(receipt *zkTypes.Receipt) bool {
+		return receipt != nil && receipt.L1BatchNumber != nil && c.isBatchFinal(ctx, receipt.L1BatchNumber.ToInt())
+	})
+}
+
+// isBatchFinal reports whether the batch is executed on L1, in which case its blocks and transactions
+// can no longer change.
+func (c *CachingClient) isBatchFinal(ctx context.Context, l1BatchNumber *big.Int) bool {
+	if l1BatchNumber == nil {
+		return false
+	}
+	details, err := c.L1BatchDetails(ctx, l1BatchNumber)
+	return err == nil && details != nil && isFinalStatus(details.Status)
+}
+
+// cached returns the value stored under the key, or fetches it and stores it if the final
+// function reports that the value can no longer change.
+func cached[T any](c *CachingClient, key string, fetch func() (T, error), final func(T) bool) (T, error) {
+	key = c.chainID.String() + "/" + key
+	if data, ok, err := c.store.Get(key); err == nil && ok {
+		var value T
+		if err = json.Unmarshal(data, &value); err == nil {
+			return value, nil
+		}
+	}
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+	if final(value) {
+		if data, errMarshal := json.Marshal(value); errMarshal == nil {
+			_ = c.store.Set(key, data)
+		}
+	}
+	return value, nil
+}
+
+func isNonZeroAddress(address common.Address) bool {
+	return address != (common.Address{})
+}
+
+func isFinalStatus(status string) bool {
+	return status == "verified"
+}
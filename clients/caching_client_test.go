@@ -0,0 +1,125 @@
+package clients
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"math/big"
+	"testing"
+)
+
+// cachingTestClient is the stand-in for the client which serves the batch 1 as verified and the batch 2
+// as sealed, and counts the requests.
+type cachingTestClient struct {
+	cachedClient
+	calls map[string]int
+}
+
+func (c *cachingTestClient) ChainID(_ context.Context) (*big.Int, error) {
+	return big.NewInt(270), nil
+}
+
+func (c *cachingTestClient) L1BatchDetails(_ context.Context, l1BatchNumber *big.Int) (*zkTypes.BatchDetails, error) {
+	c.calls["L1BatchDetails"]++
+	status := "sealed"
+	if l1BatchNumber.Int64() == 1 {
+		status = "verified"
+	}
+	return &zkTypes.BatchDetails{Number: uint(l1BatchNumber.Uint64()), Status: status}, nil
+}
+
+func (c *cachingTestClient) TransactionReceipt(_ context.Context, txHash common.Hash) (*zkTypes.Receipt, error) {
+	c.calls["TransactionReceipt"]++
+	return &zkTypes.Receipt{
+		Receipt: types.Receipt{
+			TxHash:            txHash,
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: 21000,
+			GasUsed:           21000,
+			Logs:              []*types.Log{},
+		},
+		L1BatchNumber: (*hexutil.Big)(new(big.Int).SetBytes(txHash.Bytes())),
+		Logs:          []*zkTypes.Log{},
+		L2ToL1Logs:    []*zkTypes.L2ToL1Log{},
+	}, nil
+}
+
+func (c *cachingTestClient) MainContractAddress(_ context.Context) (common.Address, error) {
+	c.calls["MainContractAddress"]++
+	return common.HexToAddress("0x01"), nil
+}
+
+func TestCachingClient(t *testing.T) {
+	ctx := context.Background()
+	client := &cachingTestClient{calls: make(map[string]int)}
+	store := NewLRUCacheStore(10)
+	cachingClient, err := NewCachingClient(ctx, client, store)
+	assert.NoError(t, err, "NewCachingClient should not return error")
+	var _ Client = cachingClient
+
+	for i := 0; i < 2; i++ {
+		address, errAddress := cachingClient.MainContractAddress(ctx)
+		assert.NoError(t, errAddress, "MainContractAddress should not return error")
+		assert.Equal(t, common.HexToAddress("0x01"), address, "Addresses should be the same")
+
+		details, errDetails := cachingClient.L1BatchDetails(ctx, big.NewInt(2))
+		assert.NoError(t, errDetails, "L1BatchDetails should not return error")
+		assert.Equal(t, "sealed", details.Status, "Statuses should be the same")
+
+		receipt, errReceipt := cachingClient.TransactionReceipt(ctx, common.HexToHash("0x01"))
+		assert.NoError(t, errReceipt, "TransactionReceipt should not return error")
+		assert.Equal(t, common.HexToHash("0x01"), receipt.TxHash, "Hashes should be the same")
+		assert.Equal(t, big.NewInt(1), receipt.L1BatchNumber.ToInt(), "Batch numbers should be the same")
+	}
+	assert.Equal(t, 1, client.calls["MainContractAddress"], "Main contract address should be cached")
+	assert.Equal(t, 1, client.calls["TransactionReceipt"], "Receipt of verified batch should be cached")
+	assert.Equal(t, 3, client.calls["L1BatchDetails"], "Only details of verified batch should be cached")
+
+	_, err = cachingClient.TransactionReceipt(ctx, common.HexToHash("0x02"))
+	assert.NoError(t, err, "TransactionReceipt should not return error")
+	_, err = cachingClient.TransactionReceipt(ctx, common.HexToHash("0x02"))
+	assert.NoError(t, err, "TransactionReceipt should not return error")
+	assert.Equal(t, 3, client.calls["TransactionReceipt"], "Receipt of sealed batch should not be cached")
+}
+
+func TestDiskCacheStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	client := &cachingTestClient{calls: make(map[string]int)}
+	for i := 0; i < 2; i++ {
+		store, err := NewDiskCacheStore(dir)
+		assert.NoError(t, err, "NewDiskCacheStore should not return error")
+		cachingClient, err := NewCachingClient(ctx, client, store)
+		assert.NoError(t, err, "NewCachingClient should not return error")
+		details, err := cachingClient.L1BatchDetails(ctx, big.NewInt(1))
+		assert.NoError(t, err, "L1BatchDetails should not return error")
+		assert.Equal(t, uint(1), details.Number, "Batch numbers should be the same")
+	}
+	assert.Equal(t, 1, client.calls["L1BatchDetails"], "Details should be read from disk")
+}
+
+func TestLRUCacheStore(t *testing.T) {
+	store := NewLRUCacheStore(2)
+	assert.NoError(t, store.Set("a", []byte("1")), "Set should not return error")
+	assert.NoError(t, store.Set("b", []byte("2")), "Set should not return error")
+	_, ok, _ := store.Get("a")
+	assert.True(t, ok, "Value should be found")
+	assert.NoError(t, store.Set("c", []byte("3")), "Set should not return error")
+
+	_, ok, _ = store.Get("b")
+	assert.False(t, ok, "Least recently used value should be evicted")
+	value, ok, _ := store.Get("a")
+	assert.True(t, ok, "Value should be found")
+	assert.Equal(t, []byte("1"), value, "Values should be the same")
+	assert.Equal(t, 2, store.Len(), "Store should not exceed its size")
+
+	store = NewLRUCacheStore(0)
+	assert.NoError(t, store.Set("a", []byte("1")), "Set should not return error")
+	assert.NoError(t, store.Set("b", []byte("2")), "Set should not return error")
+	_, ok, _ = store.Get("a")
+	assert.True(t, ok, "Unbounded store should not evict values")
+	assert.Equal(t, 2, store.Len(), "Unbounded store should keep all values")
+}
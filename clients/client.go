@@ -166,6 +166,18 @@ type ZkSyncEraClient interface {
 	// BridgeContracts returns the addresses of the default zkSync Era bridge
 	// contracts on both L1 and L2.
 	BridgeContracts(ctx context.Context) (*zkTypes.BridgeContracts, error)
+	// BridgehubContractAddress returns the address of the Bridgehub contract on L1,
+	// which accepts the L1 -> L2 transactions of all chains sharing the bridge.
+	BridgehubContractAddress(ctx context.Context) (common.Address, error)
+	// BaseTokenContractAddress returns the L1 address of the base token of the chain,
+	// which is utils.EthAddressInContracts on ETH-based chains.
+	BaseTokenContractAddress(ctx context.Context) (common.Address, error)
+	// IsEthBasedChain returns whether ETH is the base token of the chain. Nodes that
+	// do not support custom base tokens are considered to serve ETH-based chains.
+	IsEthBasedChain(ctx context.Context) (bool, error)
+	// IsBaseToken returns whether the L1 token is the base token of the chain.
+	// On ETH-based chains, both utils.EthAddress and utils.EthAddressInContracts represent the base token.
+	IsBaseToken(ctx context.Context, token common.Address) (bool, error)
 	// ContractAccountInfo returns the version of the supported account abstraction
 	// and nonce ordering from a given contract address.
 	ContractAccountInfo(ctx context.Context, address common.Address) (*zkTypes.ContractAccountInfo, error)
@@ -188,6 +200,10 @@ type ZkSyncEraClient interface {
 	// LogProof returns the proof for a transaction's L2 to L1 log sent via the
 	// L1Messenger system contract.
 	LogProof(ctx context.Context, txHash common.Hash, logIndex int) (*zkTypes.MessageProof, error)
+	// Proof returns Merkle proofs for one or more storage values at the specified account
+	// in the state of the batch. The proofs can be verified against the root hash of the batch
+	// returned by L1BatchDetails using utils.VerifyStorageProof.
+	Proof(ctx context.Context, address common.Address, keys []common.Hash, l1BatchNumber *big.Int) (*zkTypes.StorageProof, error)
 	// Deprecated: Deprecated in favor of LogProof.
 	MsgProof(ctx context.Context, block uint32, sender common.Address, msg common.Hash) (*zkTypes.MessageProof, error)
 	// L2TransactionFromPriorityOp returns transaction on L2 network from transaction
@@ -199,10 +215,13 @@ type ZkSyncEraClient interface {
 	// Deprecated: Method is deprecated and will be removed in the near future.
 	TokenPrice(ctx context.Context, address common.Address) (*big.Float, error)
 	// L2TokenAddress returns the L2 token address equivalent for a L1 token address
-	// as they are not equal. ETH address is set to zero address.
+	// as they are not equal. ETH address is set to zero address. On chains whose base token
+	// is not ETH, the base token is set to utils.L2BaseTokenAddress and ETH is set to the
+	// address of the bridged ETH token.
 	L2TokenAddress(ctx context.Context, token common.Address) (common.Address, error)
 	// L1TokenAddress returns the L1 token address equivalent for a L2 token address
-	// as they are not equal. ETH address is set to zero address.
+	// as they are not equal. ETH address is set to zero address. The utils.L2BaseTokenAddress
+	// is set to the L1 address of the base token.
 	L1TokenAddress(ctx context.Context, token common.Address) (common.Address, error)
 	// AllAccountBalances returns all balances for confirmed tokens given by an
 	// account address.
@@ -228,4 +247,8 @@ type ZkSyncEraClient interface {
 type Client interface {
 	EthereumClient
 	ZkSyncEraClient
+
+	// NewBatch creates a batch which queues requests and sends them to the node using
+	// JSON-RPC batches, splitting them into batches of at most DefaultMaxBatchSize requests.
+	NewBatch() *Batch
 }
@@ -0,0 +1,456 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// FailoverConfig configures the FailoverClient. Zero values select the defaults described
+// for each field.
+type FailoverConfig struct {
+	// ChainID is the chain ID which endpoints must serve to be healthy. If nil, the chain ID
+	// of the first endpoint that responds is used.
+	ChainID *big.Int
+	// MaxBlockLag is the maximum number of blocks an endpoint may be behind the most advanced
+	// endpoint to be healthy. Zero disables the check.
+	MaxBlockLag uint64
+	// HealthCheckInterval is the interval of the background health checks. Zero disables them,
+	// in which case the health is checked only on dial and on CheckHealth call.
+	HealthCheckInterval time.Duration
+	// MaxRetries is the number of times the request is retried on another endpoint after
+	// a transport error or rate limiting. Defaults to 3.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry, which is doubled for each following retry.
+	// Defaults to 100ms.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay between retries. Defaults to 2s.
+	MaxBackoff time.Duration
+	// HTTPClient is the client used to send the requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// EndpointStatus describes the state of the endpoint used by the FailoverClient.
+type EndpointStatus struct {
+	URL         string        // The URL of the endpoint.
+	Healthy     bool          // Whether the endpoint receives requests.
+	ChainID     *big.Int      // The chain ID reported by the last health check.
+	BlockNumber uint64        // The block number reported by the last health check.
+	Latency     time.Duration // The moving average of the latency of successful requests.
+	Requests    uint64        // The number of requests sent to the endpoint.
+	Failures    uint64        // The number of requests which failed or were rate limited.
+	LastError   error         // The last error of the endpoint.
+}
+
+// FailoverClient is the Client which spreads the requests over several HTTP endpoints
+// of the same chain. Read requests are routed round-robin over healthy endpoints, while
+// transactions are always sent to the same endpoint as long as it is healthy, so that
+// they reach the mempool in order. Requests that fail due to transport errors or rate
+// limiting are retried with backoff on the next endpoint. The endpoints are considered
+// healthy when they serve the expected chain and their head does not lag behind.
+type FailoverClient struct {
+	*BaseClient
+	transport *failoverTransport
+	stop      chan struct{}
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+}
+
+// DialFailover connects a client to the given HTTP endpoints and checks their health.
+// It returns an error if none of the endpoints is healthy.
+func DialFailover(ctx context.Context, rawUrls []string, config FailoverConfig) (*FailoverClient, error) {
+	if len(rawUrls) == 0 {
+		return nil, errors.New("no endpoints provided")
+	}
+	config = config.withDefaults()
+
+	transport := &failoverTransport{
+		base:    config.HTTPClient.Transport,
+		config:  config,
+		chainID: config.ChainID,
+	}
+	if transport.base == nil {
+		transport.base = http.DefaultTransport
+	}
+	for _, rawUrl := range rawUrls {
+		u, err := url.Parse(rawUrl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse endpoint URL: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("unsupported scheme of endpoint %s", rawUrl)
+		}
+		rpcClient, err := rpc.DialOptions(ctx, rawUrl, rpc.WithHTTPClient(config.HTTPClient))
+		if err != nil {
+			transport.close()
+			return nil, fmt.Errorf("failed to dial endpoint %s: %w", rawUrl, err)
+		}
+		transport.endpoints = append(transport.endpoints, &failoverEndpoint{
+			url:       u,
+			rpcClient: rpcClient,
+		})
+	}
+
+	rpcClient, err := rpc.DialOptions(ctx, rawUrls[0], rpc.WithHTTPClient(&http.Client{
+		Transport: transport,
+		Timeout:   config.HTTPClient.Timeout,
+	}))
+	if err != nil {
+		transport.close()
+		return nil, fmt.Errorf("failed to dial: %w", err)
+	}
+	c := &FailoverClient{
+		BaseClient: NewClient(rpcClient).(*BaseClient),
+		transport:  transport,
+		stop:       make(chan struct{}),
+	}
+	if err = c.CheckHealth(ctx); err != nil {
+		c.Close()
+		return nil, err
+	}
+	if config.HealthCheckInterval > 0 {
+		c.wg.Add(1)
+		go c.checkHealthLoop(config.HealthCheckInterval)
+	}
+	return c, nil
+}
+
+// CheckHealth queries the chain ID and the block number of all endpoints, and updates their health.
+// It returns an error if none of the endpoints is healthy.
+func (c *FailoverClient) CheckHealth(ctx context.Context) error {
+	return c.transport.checkHealth(ctx)
+}
+
+// Endpoints returns the status of the endpoints, in the order they are provided.
+func (c *FailoverClient) Endpoints() []EndpointStatus {
+	statuses := make([]EndpointStatus, len(c.transport.endpoints))
+	for i, e := range c.transport.endpoints {
+		statuses[i] = e.status()
+	}
+	return statuses
+}
+
+func (c *FailoverClient) Close() {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+		c.wg.Wait()
+		c.BaseClient.Close()
+		c.transport.close()
+	})
+}
+
+func (c *FailoverClient) checkHealthLoop(interval time.Duration) {
+	defer c.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			_ = c.CheckHealth(ctx)
+			cancel()
+		}
+	}
+}
+
+func (c FailoverConfig) withDefaults() FailoverConfig {
+	if c.MaxRetries == 0 {
+		c.MaxRetries = 3
+	}
+	if c.MinBackoff == 0 {
+		c.MinBackoff = 100 * time.Millisecond
+	}
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = 2 * time.Second
+	}
+	if c.HTTPClient == nil {
+		c.HTTPClient = http.DefaultClient
+	}
+	return c
+}
+
+// failoverEndpoint holds the state of the endpoint. The healthy flag is set by the health checks
+// and cleared on transport errors.
+type failoverEndpoint struct {
+	url       *url.URL
+	rpcClient *rpc.Client
+
+	mu          sync.Mutex
+	healthy     bool
+	chainID     *big.Int
+	blockNumber uint64
+	latency     time.Duration
+	requests    uint64
+	failures    uint64
+	lastErr     error
+}
+
+func (e *failoverEndpoint) isHealthy() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.healthy
+}
+
+func (e *failoverEndpoint) status() EndpointStatus {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return EndpointStatus{
+		URL:         e.url.String(),
+		Healthy:     e.healthy,
+		ChainID:     e.chainID,
+		BlockNumber: e.blockNumber,
+		Latency:     e.latency,
+		Requests:    e.requests,
+		Failures:    e.failures,
+		LastError:   e.lastErr,
+	}
+}
+
+// recordSuccess updates the moving average of the latency with the duration of the successful request.
+func (e *failoverEndpoint) recordSuccess(d time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.requests++
+	if e.latency == 0 {
+		e.latency = d
+	} else {
+		e.latency += (d - e.latency) / 5
+	}
+}
+
+// recordFailure counts the failed request. Transport errors make the endpoint unhealthy until
+// the next health check, while rate limiting does not.
+func (e *failoverEndpoint) recordFailure(err error, unhealthy bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.requests++
+	e.failures++
+	e.lastErr = err
+	if unhealthy {
+		e.healthy = false
+	}
+}
+
+// failoverTransport is the http.RoundTripper which routes the JSON-RPC requests over the endpoints.
+type failoverTransport struct {
+	base      http.RoundTripper
+	config    FailoverConfig
+	endpoints []*failoverEndpoint
+
+	mu       sync.Mutex
+	chainID  *big.Int
+	next     int // The endpoint which receives the next read request.
+	pinned   int // The endpoint which receives transactions.
+	checking sync.Mutex
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	write := isWriteRequest(body)
+
+	var lastErr error
+	for attempt := 0; attempt <= t.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			if err := sleepContext(req.Context(), t.backoff(attempt)); err != nil {
+				return nil, err
+			}
+		}
+		endpoint := t.pick(write)
+		start := time.Now()
+		resp, err := t.base.RoundTrip(endpointRequest(req, endpoint.url, body))
+		switch {
+		case err != nil:
+			if req.Context().Err() != nil {
+				return nil, err
+			}
+			lastErr = fmt.Errorf("endpoint %s: %w", endpoint.url, err)
+			endpoint.recordFailure(lastErr, true)
+		case isRetryableStatus(resp.StatusCode):
+			_ = resp.Body.Close()
+			lastErr = fmt.Errorf("endpoint %s responded with %s", endpoint.url, resp.Status)
+			endpoint.recordFailure(lastErr, resp.StatusCode != http.StatusTooManyRequests)
+		default:
+			endpoint.recordSuccess(time.Since(start))
+			return resp, nil
+		}
+	}
+	return nil, lastErr
+}
+
+// pick returns the endpoint for the request. Reads go round-robin over healthy endpoints, while writes
+// go to the pinned endpoint, which changes only when it becomes unhealthy. If none of the endpoints
+// is healthy, all of them are used.
+func (t *failoverTransport) pick(write bool) *failoverEndpoint {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n := len(t.endpoints)
+	if write {
+		for i := 0; i < n; i++ {
+			idx := (t.pinned + i) % n
+			if t.endpoints[idx].isHealthy() {
+				t.pinned = idx
+				return t.endpoints[idx]
+			}
+		}
+		return t.endpoints[t.pinned]
+	}
+	for i := 0; i < n; i++ {
+		idx := (t.next + i) % n
+		if t.endpoints[idx].isHealthy() {
+			t.next = idx + 1
+			return t.endpoints[idx]
+		}
+	}
+	idx := t.next % n
+	t.next = idx + 1
+	return t.endpoints[idx]
+}
+
+func (t *failoverTransport) backoff(attempt int) time.Duration {
+	backoff := t.config.MinBackoff
+	for i := 1; i < attempt && backoff < t.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > t.config.MaxBackoff {
+		backoff = t.config.MaxBackoff
+	}
+	return backoff
+}
+
+func (t *failoverTransport) checkHealth(ctx context.Context) error {
+	t.checking.Lock()
+	defer t.checking.Unlock()
+
+	type head struct {
+		chainID     *big.Int
+		blockNumber uint64
+		err         error
+	}
+	heads := make([]head, len(t.endpoints))
+	var wg sync.WaitGroup
+	for i, e := range t.endpoints {
+		wg.Add(1)
+		go func(i int, e *failoverEndpoint) {
+			defer wg.Done()
+			var chainID hexutil.Big
+			var blockNumber hexutil.Uint64
+			batch := []rpc.BatchElem{
+				{Method: "eth_chainId", Result: &chainID},
+				{Method: "eth_blockNumber", Result: &blockNumber},
+			}
+			err := e.rpcClient.BatchCallContext(ctx, batch)
+			if err == nil {
+				err = errors.Join(batch[0].Error, batch[1].Error)
+			}
+			heads[i] = head{(*big.Int)(&chainID), uint64(blockNumber), err}
+		}(i, e)
+	}
+	wg.Wait()
+
+	t.mu.Lock()
+	if t.chainID == nil {
+		for _, h := range heads {
+			if h.err == nil {
+				t.chainID = h.chainID
+				break
+			}
+		}
+	}
+	chainID := t.chainID
+	t.mu.Unlock()
+
+	var maxBlockNumber uint64
+	for _, h := range heads {
+		if h.err == nil && h.chainID.Cmp(chainID) == 0 && h.blockNumber > maxBlockNumber {
+			maxBlockNumber = h.blockNumber
+		}
+	}
+	anyHealthy := false
+	for i, e := range t.endpoints {
+		h := heads[i]
+		switch {
+		case h.err != nil:
+			h.err = fmt.Errorf("endpoint %s: failed to check health: %w", e.url, h.err)
+		case h.chainID.Cmp(chainID) != 0:
+			h.err = fmt.Errorf("endpoint %s serves chain %s instead of %s", e.url, h.chainID, chainID)
+		case t.config.MaxBlockLag > 0 && h.blockNumber+t.config.MaxBlockLag < maxBlockNumber:
+			h.err = fmt.Errorf("endpoint %s is %d blocks behind", e.url, maxBlockNumber-h.blockNumber)
+		}
+		e.mu.Lock()
+		e.healthy = h.err == nil
+		if h.err == nil {
+			e.chainID, e.blockNumber = h.chainID, h.blockNumber
+		} else {
+			e.lastErr = h.err
+		}
+		e.mu.Unlock()
+		anyHealthy = anyHealthy || h.err == nil
+	}
+	if !anyHealthy {
+		return errors.New("none of the endpoints is healthy")
+	}
+	return nil
+}
+
+func (t *failoverTransport) close() {
+	for _, e := range t.endpoints {
+		e.rpcClient.Close()
+	}
+}
+
+// endpointRequest returns the copy of the request which is sent to the endpoint.
+func endpointRequest(req *http.Request, u *url.URL, body []byte) *http.Request {
+	r := req.Clone(req.Context())
+	r.URL = u
+	r.Host = ""
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	return r
+}
+
+// isWriteRequest reports whether the JSON-RPC request or batch submits a transaction.
+func isWriteRequest(body []byte) bool {
+	for _, method := range requestMethods(body) {
+		if method == "eth_sendRawTransaction" || method == "eth_sendTransaction" {
+			return true
+		}
+	}
+	return false
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests ||
+		status == http.StatusBadGateway ||
+		status == http.StatusServiceUnavailable ||
+		status == http.StatusGatewayTimeout
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
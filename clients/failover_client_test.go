@@ -0,0 +1,121 @@
+package clients
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// failoverTestService serves eth_chainId, eth_blockNumber, eth_getBalance and eth_sendRawTransaction,
+// and counts the requests for balances and transactions. Its endpoint responds with 429 status
+// while rateLimited is positive.
+type failoverTestService struct {
+	chainID      uint64
+	blockNumber  uint64
+	balances     atomic.Int32
+	transactions atomic.Int32
+	rateLimited  atomic.Int32
+}
+
+func (s *failoverTestService) ChainId() hexutil.Uint64 {
+	return hexutil.Uint64(s.chainID)
+}
+
+func (s *failoverTestService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.blockNumber)
+}
+
+func (s *failoverTestService) GetBalance(_ common.Address, _ string) *hexutil.Big {
+	s.balances.Add(1)
+	return (*hexutil.Big)(common.Big1)
+}
+
+func (s *failoverTestService) SendRawTransaction(_ hexutil.Bytes) common.Hash {
+	s.transactions.Add(1)
+	return common.Hash{}
+}
+
+func newFailoverTestEndpoint(t *testing.T, service *failoverTestService) string {
+	server := rpc.NewServer()
+	assert.NoError(t, server.RegisterName("eth", service), "RegisterName should not return error")
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if service.rateLimited.Add(-1) >= 0 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		server.ServeHTTP(w, r)
+	}))
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+	return httpServer.URL
+}
+
+func TestFailoverClient(t *testing.T) {
+	healthy := []*failoverTestService{{chainID: 270, blockNumber: 100}, {chainID: 270, blockNumber: 98}}
+	otherChain := &failoverTestService{chainID: 1, blockNumber: 100}
+	lagging := &failoverTestService{chainID: 270, blockNumber: 50}
+	urls := []string{
+		newFailoverTestEndpoint(t, otherChain),
+		newFailoverTestEndpoint(t, healthy[0]),
+		newFailoverTestEndpoint(t, lagging),
+		newFailoverTestEndpoint(t, healthy[1]),
+	}
+
+	client, err := DialFailover(context.Background(), urls, FailoverConfig{ChainID: big.NewInt(270), MaxBlockLag: 10})
+	assert.NoError(t, err, "DialFailover should not return error")
+	defer client.Close()
+	var _ Client = client
+
+	statuses := client.Endpoints()
+	assert.Equal(t, []bool{false, true, false, true},
+		[]bool{statuses[0].Healthy, statuses[1].Healthy, statuses[2].Healthy, statuses[3].Healthy},
+		"Only endpoints of the chain which do not lag should be healthy")
+
+	for i := 0; i < 4; i++ {
+		_, err = client.BalanceAt(context.Background(), common.Address{}, nil)
+		assert.NoError(t, err, "BalanceAt should not return error")
+		err = client.SendTransaction(context.Background(), types.NewTx(&types.LegacyTx{}))
+		assert.NoError(t, err, "SendTransaction should not return error")
+	}
+	assert.Equal(t, int32(2), healthy[0].balances.Load(), "Reads should be spread over healthy endpoints")
+	assert.Equal(t, int32(2), healthy[1].balances.Load(), "Reads should be spread over healthy endpoints")
+	assert.Equal(t, int32(0), otherChain.balances.Load()+lagging.balances.Load(), "Reads should not be sent to unhealthy endpoints")
+	assert.Equal(t, int32(4), healthy[0].transactions.Load(), "Writes should be pinned to a single endpoint")
+	assert.Equal(t, uint64(6), client.Endpoints()[1].Requests, "Requests should be counted")
+	assert.NotZero(t, client.Endpoints()[1].Latency, "Latency should be tracked")
+}
+
+func TestFailoverClientRetry(t *testing.T) {
+	limited := &failoverTestService{chainID: 270, blockNumber: 100}
+	fallback := &failoverTestService{chainID: 270, blockNumber: 100}
+	urls := []string{newFailoverTestEndpoint(t, limited), newFailoverTestEndpoint(t, fallback)}
+
+	client, err := DialFailover(context.Background(), urls, FailoverConfig{MinBackoff: 1})
+	assert.NoError(t, err, "DialFailover should not return error")
+	defer client.Close()
+
+	limited.rateLimited.Store(1)
+	_, err = client.BalanceAt(context.Background(), common.Address{}, nil)
+	assert.NoError(t, err, "BalanceAt should retry rate limited request")
+	assert.Equal(t, int32(1), fallback.balances.Load(), "Rate limited request should be retried on another endpoint")
+	assert.Equal(t, uint64(1), client.Endpoints()[0].Failures, "Rate limited requests should be counted as failures")
+	assert.True(t, client.Endpoints()[0].Healthy, "Rate limited endpoint should stay healthy")
+
+	limited.rateLimited.Store(10)
+	fallback.rateLimited.Store(10)
+	_, err = client.BalanceAt(context.Background(), common.Address{}, nil)
+	assert.Error(t, err, "BalanceAt should return error when retries are exhausted")
+
+	_, err = DialFailover(context.Background(), []string{"ws://localhost"}, FailoverConfig{})
+	assert.Error(t, err, "DialFailover should return error for unsupported scheme")
+}
@@ -0,0 +1,115 @@
+package clients
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RPCMetrics holds the Prometheus-style counters of the JSON-RPC calls, labeled by the method
+// and the error class. The counters are updated by the middleware returned by Middleware and
+// can be exposed in the Prometheus text format with WriteTo.
+type RPCMetrics struct {
+	mu       sync.Mutex
+	counters map[rpcMetricsKey]*rpcCounters
+}
+
+type rpcMetricsKey struct {
+	method     string
+	errorClass string
+}
+
+type rpcCounters struct {
+	calls         uint64
+	seconds       float64
+	requestBytes  uint64
+	responseBytes uint64
+}
+
+// NewRPCMetrics creates empty counters.
+func NewRPCMetrics() *RPCMetrics {
+	return &RPCMetrics{
+		counters: make(map[rpcMetricsKey]*rpcCounters),
+	}
+}
+
+// Middleware returns the middleware which counts the calls, their duration and payload sizes.
+func (m *RPCMetrics) Middleware() Middleware {
+	return func(next RPCHandler) RPCHandler {
+		return func(req *RPCRequest) (*RPCResponse, error) {
+			start := time.Now()
+			resp, err := next(req)
+			responseBytes := 0
+			if resp != nil {
+				responseBytes = len(resp.Body)
+			}
+			m.observe(req.Method(), ErrorClass(resp, err), time.Since(start), len(req.Body), responseBytes)
+			return resp, err
+		}
+	}
+}
+
+// Calls returns the number of calls of the method which ended with the given error class.
+func (m *RPCMetrics) Calls(method, errorClass string) uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if c, ok := m.counters[rpcMetricsKey{method, errorClass}]; ok {
+		return c.calls
+	}
+	return 0
+}
+
+// WriteTo writes the counters in the Prometheus text exposition format.
+func (m *RPCMetrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	keys := make([]rpcMetricsKey, 0, len(m.counters))
+	counters := make(map[rpcMetricsKey]rpcCounters, len(m.counters))
+	for key, c := range m.counters {
+		keys = append(keys, key)
+		counters[key] = *c
+	}
+	m.mu.Unlock()
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].method != keys[j].method {
+			return keys[i].method < keys[j].method
+		}
+		return keys[i].errorClass < keys[j].errorClass
+	})
+
+	var b strings.Builder
+	metrics := []struct {
+		name, help string
+		value      func(c rpcCounters) string
+	}{
+		{"zksync_rpc_calls_total", "Number of JSON-RPC calls.", func(c rpcCounters) string { return fmt.Sprint(c.calls) }},
+		{"zksync_rpc_call_duration_seconds_total", "Total duration of JSON-RPC calls.", func(c rpcCounters) string { return fmt.Sprint(c.seconds) }},
+		{"zksync_rpc_request_bytes_total", "Total size of JSON-RPC requests.", func(c rpcCounters) string { return fmt.Sprint(c.requestBytes) }},
+		{"zksync_rpc_response_bytes_total", "Total size of JSON-RPC responses.", func(c rpcCounters) string { return fmt.Sprint(c.responseBytes) }},
+	}
+	for _, metric := range metrics {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s counter\n", metric.name, metric.help, metric.name)
+		for _, key := range keys {
+			fmt.Fprintf(&b, "%s{method=%q,error_class=%q} %s\n", metric.name, key.method, key.errorClass, metric.value(counters[key]))
+		}
+	}
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+func (m *RPCMetrics) observe(method, errorClass string, duration time.Duration, requestBytes, responseBytes int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := rpcMetricsKey{method, errorClass}
+	c, ok := m.counters[key]
+	if !ok {
+		c = &rpcCounters{}
+		m.counters[key] = c
+	}
+	c.calls++
+	c.seconds += duration.Seconds()
+	c.requestBytes += uint64(requestBytes)
+	c.responseBytes += uint64(responseBytes)
+}
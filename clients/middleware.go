@@ -0,0 +1,226 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/rpc"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Error classes of the JSON-RPC calls reported by ErrorClass.
+const (
+	ErrorClassNone      = ""          // The call succeeded.
+	ErrorClassTransport = "transport" // The request could not be sent or the response could not be read.
+	ErrorClassHTTP      = "http"      // The node responded with non-2xx status.
+	ErrorClassRPC       = "rpc"       // The node responded with JSON-RPC error.
+)
+
+// RPCRequest is the JSON-RPC request, or batch of requests, sent by the client over HTTP.
+// Middleware may modify the embedded HTTP request, e.g. to set authorization headers, and the body.
+type RPCRequest struct {
+	*http.Request
+	Methods []string // The methods of the requests, more than one for batches.
+	Body    []byte   // The JSON-RPC payload.
+}
+
+// Method returns the method of the request, or "batch" for batches.
+func (r *RPCRequest) Method() string {
+	if len(r.Methods) == 1 {
+		return r.Methods[0]
+	}
+	return "batch"
+}
+
+// RPCResponse is the response of the node to the RPCRequest.
+type RPCResponse struct {
+	*http.Response
+	Body []byte // The JSON-RPC payload.
+}
+
+// RPCHandler sends the RPCRequest and returns the response of the node.
+type RPCHandler func(req *RPCRequest) (*RPCResponse, error)
+
+// Middleware wraps the RPCHandler to observe or modify the requests sent by the client.
+type Middleware func(next RPCHandler) RPCHandler
+
+// DialWithMiddleware connects a client to the given HTTP URL, passing every request through the middleware.
+// The first middleware is the outermost one, i.e. it observes the request first and the response last.
+func DialWithMiddleware(ctx context.Context, rawUrl string, middlewares ...Middleware) (Client, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported scheme of URL %s", rawUrl)
+	}
+	c, err := rpc.DialOptions(ctx, rawUrl, rpc.WithHTTPClient(&http.Client{
+		Transport: NewMiddlewareTransport(nil, middlewares...),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(c), nil
+}
+
+// NewMiddlewareTransport creates the http.RoundTripper which passes the JSON-RPC requests through
+// the middleware before sending them with the base transport, which defaults to http.DefaultTransport.
+// It allows the middleware to be used with other clients, e.g. the FailoverClient via FailoverConfig.HTTPClient.
+func NewMiddlewareTransport(base http.RoundTripper, middlewares ...Middleware) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	handler := func(req *RPCRequest) (*RPCResponse, error) {
+		r := req.Request.Clone(req.Context())
+		r.Body = io.NopCloser(bytes.NewReader(req.Body))
+		r.ContentLength = int64(len(req.Body))
+		resp, err := base.RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response: %w", err)
+		}
+		return &RPCResponse{Response: resp, Body: body}, nil
+	}
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return middlewareTransport(handler)
+}
+
+// middlewareTransport is the http.RoundTripper which sends the requests using the RPCHandler.
+type middlewareTransport RPCHandler
+
+func (t middlewareTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := t(&RPCRequest{
+		Request: req.Clone(req.Context()),
+		Methods: requestMethods(body),
+		Body:    body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	httpResp := resp.Response
+	httpResp.Body = io.NopCloser(bytes.NewReader(resp.Body))
+	httpResp.ContentLength = int64(len(resp.Body))
+	return httpResp, nil
+}
+
+// ErrorClass classifies the outcome of the JSON-RPC call as one of ErrorClassNone, ErrorClassTransport,
+// ErrorClassHTTP or ErrorClassRPC.
+func ErrorClass(resp *RPCResponse, err error) string {
+	switch {
+	case err != nil || resp == nil:
+		return ErrorClassTransport
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return ErrorClassHTTP
+	case hasRPCError(resp.Body):
+		return ErrorClassRPC
+	default:
+		return ErrorClassNone
+	}
+}
+
+// LoggingMiddleware logs every call with its method, latency, error class and payload sizes.
+// Successful calls are logged at debug level, failed ones at warn level.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next RPCHandler) RPCHandler {
+		return func(req *RPCRequest) (*RPCResponse, error) {
+			start := time.Now()
+			resp, err := next(req)
+			attrs := []slog.Attr{
+				slog.String("method", req.Method()),
+				slog.Duration("duration", time.Since(start)),
+				slog.Int("request_size", len(req.Body)),
+			}
+			if len(req.Methods) > 1 {
+				attrs = append(attrs, slog.String("methods", strings.Join(req.Methods, ",")))
+			}
+			level := slog.LevelDebug
+			if class := ErrorClass(resp, err); class != ErrorClassNone {
+				level = slog.LevelWarn
+				attrs = append(attrs, slog.String("error_class", class))
+			}
+			if resp != nil {
+				attrs = append(attrs, slog.Int("status", resp.StatusCode), slog.Int("response_size", len(resp.Body)))
+			}
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+			logger.LogAttrs(req.Context(), level, "JSON-RPC call", attrs...)
+			return resp, err
+		}
+	}
+}
+
+// HeaderMiddleware sets the given headers on every request, e.g. to authorize the client with the node.
+func HeaderMiddleware(headers http.Header) Middleware {
+	return func(next RPCHandler) RPCHandler {
+		return func(req *RPCRequest) (*RPCResponse, error) {
+			if req.Header == nil {
+				req.Header = make(http.Header)
+			}
+			for key, values := range headers {
+				req.Header[key] = values
+			}
+			return next(req)
+		}
+	}
+}
+
+// rpcMessage holds the fields of the JSON-RPC request or response which are inspected by the middleware.
+type rpcMessage struct {
+	Method string          `json:"method"`
+	Error  json.RawMessage `json:"error"`
+}
+
+// parseMessages parses the single JSON-RPC message or the batch of messages.
+func parseMessages(body []byte) []rpcMessage {
+	var msgs []rpcMessage
+	if err := json.Unmarshal(body, &msgs); err != nil {
+		var msg rpcMessage
+		if err = json.Unmarshal(body, &msg); err != nil {
+			return nil
+		}
+		msgs = []rpcMessage{msg}
+	}
+	return msgs
+}
+
+// requestMethods returns the methods of the JSON-RPC request or batch.
+func requestMethods(body []byte) []string {
+	msgs := parseMessages(body)
+	methods := make([]string, len(msgs))
+	for i, msg := range msgs {
+		methods[i] = msg.Method
+	}
+	return methods
+}
+
+// hasRPCError reports whether the JSON-RPC response, or any response of the batch, is an error.
+func hasRPCError(body []byte) bool {
+	for _, msg := range parseMessages(body) {
+		if len(msg.Error) > 0 && string(msg.Error) != "null" {
+			return true
+		}
+	}
+	return false
+}
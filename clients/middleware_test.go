@@ -0,0 +1,97 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// middlewareTestService serves eth_blockNumber, and fails eth_chainId.
+type middlewareTestService struct{}
+
+func (s *middlewareTestService) BlockNumber() hexutil.Uint64 {
+	return 7
+}
+
+func (s *middlewareTestService) ChainId() (hexutil.Uint64, error) {
+	return 0, errors.New("chain ID is not available")
+}
+
+// newMiddlewareTestEndpoint starts the endpoint which rejects requests without the authorization header.
+func newMiddlewareTestEndpoint(t *testing.T) string {
+	server := rpc.NewServer()
+	assert.NoError(t, server.RegisterName("eth", &middlewareTestService{}), "RegisterName should not return error")
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		server.ServeHTTP(w, r)
+	}))
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+	return httpServer.URL
+}
+
+func TestDialWithMiddleware(t *testing.T) {
+	url := newMiddlewareTestEndpoint(t)
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	metrics := NewRPCMetrics()
+	var order []string
+	tracing := func(name string) Middleware {
+		return func(next RPCHandler) RPCHandler {
+			return func(req *RPCRequest) (*RPCResponse, error) {
+				order = append(order, name)
+				return next(req)
+			}
+		}
+	}
+
+	client, err := DialWithMiddleware(context.Background(), url,
+		tracing("outer"),
+		LoggingMiddleware(logger),
+		metrics.Middleware(),
+		HeaderMiddleware(http.Header{"Authorization": {"Bearer token"}}),
+		tracing("inner"),
+	)
+	assert.NoError(t, err, "DialWithMiddleware should not return error")
+	defer client.Close()
+
+	blockNumber, err := client.BlockNumber(context.Background())
+	assert.NoError(t, err, "BlockNumber should not return error")
+	assert.Equal(t, uint64(7), blockNumber, "Block numbers should be the same")
+	_, err = client.ChainID(context.Background())
+	assert.Error(t, err, "ChainID should return error")
+
+	assert.Equal(t, []string{"outer", "inner", "outer", "inner"}, order, "Middleware should be applied in order")
+	assert.Equal(t, uint64(1), metrics.Calls("eth_blockNumber", ErrorClassNone), "Successful call should be counted")
+	assert.Equal(t, uint64(1), metrics.Calls("eth_chainId", ErrorClassRPC), "Failed call should be counted")
+	assert.Contains(t, logs.String(), "level=DEBUG msg=\"JSON-RPC call\" method=eth_blockNumber", "Successful call should be logged")
+	assert.Contains(t, logs.String(), "error_class=rpc", "Failed call should be logged")
+
+	var exposition strings.Builder
+	_, err = metrics.WriteTo(&exposition)
+	assert.NoError(t, err, "WriteTo should not return error")
+	assert.Contains(t, exposition.String(), `zksync_rpc_calls_total{method="eth_chainId",error_class="rpc"} 1`, "Counters should be exposed")
+}
+
+func TestErrorClass(t *testing.T) {
+	ok := &RPCResponse{Response: &http.Response{StatusCode: http.StatusOK}, Body: []byte(`[{"result":"0x1"},{"error":null}]`)}
+	assert.Equal(t, ErrorClassNone, ErrorClass(ok, nil), "Error classes should be the same")
+	batchErr := &RPCResponse{Response: &http.Response{StatusCode: http.StatusOK}, Body: []byte(`[{"result":"0x1"},{"error":{"code":-32000}}]`)}
+	assert.Equal(t, ErrorClassRPC, ErrorClass(batchErr, nil), "Error classes should be the same")
+	limited := &RPCResponse{Response: &http.Response{StatusCode: http.StatusTooManyRequests}}
+	assert.Equal(t, ErrorClassHTTP, ErrorClass(limited, nil), "Error classes should be the same")
+	assert.Equal(t, ErrorClassTransport, ErrorClass(nil, errors.New("connection refused")), "Error classes should be the same")
+}
@@ -1,11 +1,15 @@
 package clients
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
 	"math/big"
 )
 
@@ -79,3 +83,51 @@ func toBlockNumArg(number *big.Int) string {
 	// It's negative and large, which is invalid.
 	return fmt.Sprintf("<invalid %d>", number)
 }
+
+// isMethodNotFound reports whether the error is returned by a node that does not support the RPC method.
+func isMethodNotFound(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32601
+}
+
+// ResolveL2Token returns the address under which the L2 token is held on L2 network. The base token,
+// referenced by either utils.EthAddress on ETH-based chains or utils.L2BaseTokenAddress, is held natively
+// and is resolved to utils.EthAddress. On chains whose base token is not ETH, utils.EthAddress is resolved
+// to the address of the bridged ETH token. Other tokens are returned unchanged.
+func ResolveL2Token(ctx context.Context, client Client, token common.Address) (common.Address, error) {
+	if token == utils.L2BaseTokenAddress {
+		return utils.EthAddress, nil
+	}
+	if token != utils.EthAddress {
+		return token, nil
+	}
+	isEthBasedChain, err := client.IsEthBasedChain(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if isEthBasedChain {
+		return utils.EthAddress, nil
+	}
+	return client.L2TokenAddress(ctx, utils.EthAddressInContracts)
+}
+
+// VerifiedProof returns Merkle proofs for one or more storage values at the specified account in the state
+// of the batch, after verifying them against the root hash of the batch. Since the root hash is also provided
+// by the node, it should be compared with the state root committed to L1 network for trust-minimized reads.
+func VerifiedProof(ctx context.Context, client Client, address common.Address, keys []common.Hash, l1BatchNumber *big.Int) (*zkTypes.StorageProof, error) {
+	proof, err := client.Proof(ctx, address, keys, l1BatchNumber)
+	if err != nil {
+		return nil, err
+	}
+	batch, err := client.L1BatchDetails(ctx, l1BatchNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get batch details: %w", err)
+	}
+	if batch == nil || batch.RootHash == nil {
+		return nil, fmt.Errorf("root hash of batch %s is not available", l1BatchNumber)
+	}
+	if err = utils.VerifyStorageProof(proof, address, keys, *batch.RootHash); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
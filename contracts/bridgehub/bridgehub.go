@@ -0,0 +1,373 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bridgehub
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// L2TransactionRequestDirect is an auto generated low-level Go binding around an user-defined struct.
+type L2TransactionRequestDirect struct {
+	ChainId                  *big.Int
+	MintValue                *big.Int
+	L2Contract               common.Address
+	L2Value                  *big.Int
+	L2Calldata               []byte
+	L2GasLimit               *big.Int
+	L2GasPerPubdataByteLimit *big.Int
+	FactoryDeps              [][]byte
+	RefundRecipient          common.Address
+}
+
+// L2TransactionRequestTwoBridgesOuter is an auto generated low-level Go binding around an user-defined struct.
+type L2TransactionRequestTwoBridgesOuter struct {
+	ChainId                  *big.Int
+	MintValue                *big.Int
+	L2Value                  *big.Int
+	L2GasLimit               *big.Int
+	L2GasPerPubdataByteLimit *big.Int
+	RefundRecipient          common.Address
+	SecondBridgeAddress      common.Address
+	SecondBridgeValue        *big.Int
+	SecondBridgeCalldata     []byte
+}
+
+// IBridgehubMetaData contains all meta data concerning the IBridgehub contract.
+var IBridgehubMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"baseToken\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"function\",\"name\":\"sharedBridge\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIL1SharedBridge\"}]},{\"type\":\"function\",\"name\":\"getHyperchain\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"function\",\"name\":\"l2TransactionBaseCost\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_gasPrice\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_l2GasLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_l2GasPerPubdataByteLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"requestL2TransactionDirect\",\"stateMutability\":\"payable\",\"inputs\":[{\"name\":\"_request\",\"type\":\"tuple\",\"internalType\":\"structL2TransactionRequestDirect\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"mintValue\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"l2Contract\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"l2Value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"l2Calldata\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"l2GasLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"l2GasPerPubdataByteLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"factoryDeps\",\"type\":\"bytes[]\",\"internalType\":\"bytes[]\"},{\"name\":\"refundRecipient\",\"type\":\"address\",\"internalType\":\"address\"}]}],\"outputs\":[{\"name\":\"canonicalTxHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"function\",\"name\":\"requestL2TransactionTwoBridges\",\"stateMutability\":\"payable\",\"inputs\":[{\"name\":\"_request\",\"type\":\"tuple\",\"internalType\":\"structL2TransactionRequestTwoBridgesOuter\",\"components\":[{\"name\":\"chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"mintValue\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"l2Value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"l2GasLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"l2GasPerPubdataByteLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"refundRecipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"secondBridgeAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"secondBridgeValue\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"secondBridgeCalldata\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[{\"name\":\"canonicalTxHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]}]",
+}
+
+// IBridgehubABI is the input ABI used to generate the binding from.
+// Deprecated: Use IBridgehubMetaData.ABI instead.
+var IBridgehubABI = IBridgehubMetaData.ABI
+
+// IBridgehub is an auto generated Go binding around an Ethereum contract.
+type IBridgehub struct {
+	IBridgehubCaller     // Read-only binding to the contract
+	IBridgehubTransactor // Write-only binding to the contract
+	IBridgehubFilterer   // Log filterer for contract events
+}
+
+// IBridgehubCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IBridgehubCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IBridgehubTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IBridgehubTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IBridgehubFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IBridgehubFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IBridgehubSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IBridgehubSession struct {
+	Contract     *IBridgehub       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IBridgehubCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IBridgehubCallerSession struct {
+	Contract *IBridgehubCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// IBridgehubTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IBridgehubTransactorSession struct {
+	Contract     *IBridgehubTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// IBridgehubRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IBridgehubRaw struct {
+	Contract *IBridgehub // Generic contract binding to access the raw methods on
+}
+
+// IBridgehubCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IBridgehubCallerRaw struct {
+	Contract *IBridgehubCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IBridgehubTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IBridgehubTransactorRaw struct {
+	Contract *IBridgehubTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIBridgehub creates a new instance of IBridgehub, bound to a specific deployed contract.
+func NewIBridgehub(address common.Address, backend bind.ContractBackend) (*IBridgehub, error) {
+	contract, err := bindIBridgehub(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgehub{IBridgehubCaller: IBridgehubCaller{contract: contract}, IBridgehubTransactor: IBridgehubTransactor{contract: contract}, IBridgehubFilterer: IBridgehubFilterer{contract: contract}}, nil
+}
+
+// NewIBridgehubCaller creates a new read-only instance of IBridgehub, bound to a specific deployed contract.
+func NewIBridgehubCaller(address common.Address, caller bind.ContractCaller) (*IBridgehubCaller, error) {
+	contract, err := bindIBridgehub(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgehubCaller{contract: contract}, nil
+}
+
+// NewIBridgehubTransactor creates a new write-only instance of IBridgehub, bound to a specific deployed contract.
+func NewIBridgehubTransactor(address common.Address, transactor bind.ContractTransactor) (*IBridgehubTransactor, error) {
+	contract, err := bindIBridgehub(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgehubTransactor{contract: contract}, nil
+}
+
+// NewIBridgehubFilterer creates a new log filterer instance of IBridgehub, bound to a specific deployed contract.
+func NewIBridgehubFilterer(address common.Address, filterer bind.ContractFilterer) (*IBridgehubFilterer, error) {
+	contract, err := bindIBridgehub(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgehubFilterer{contract: contract}, nil
+}
+
+// bindIBridgehub binds a generic wrapper to an already deployed contract.
+func bindIBridgehub(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IBridgehubMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IBridgehub *IBridgehubRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IBridgehub.Contract.IBridgehubCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IBridgehub *IBridgehubRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IBridgehub.Contract.IBridgehubTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IBridgehub *IBridgehubRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IBridgehub.Contract.IBridgehubTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IBridgehub *IBridgehubCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IBridgehub.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IBridgehub *IBridgehubTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IBridgehub.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IBridgehub *IBridgehubTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IBridgehub.Contract.contract.Transact(opts, method, params...)
+}
+
+// BaseToken is a free data retrieval call binding the contract method 0x59ec65a2.
+//
+// Solidity: function baseToken(uint256 _chainId) view returns(address)
+func (_IBridgehub *IBridgehubCaller) BaseToken(opts *bind.CallOpts, _chainId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _IBridgehub.contract.Call(opts, &out, "baseToken", _chainId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// BaseToken is a free data retrieval call binding the contract method 0x59ec65a2.
+//
+// Solidity: function baseToken(uint256 _chainId) view returns(address)
+func (_IBridgehub *IBridgehubSession) BaseToken(_chainId *big.Int) (common.Address, error) {
+	return _IBridgehub.Contract.BaseToken(&_IBridgehub.CallOpts, _chainId)
+}
+
+// BaseToken is a free data retrieval call binding the contract method 0x59ec65a2.
+//
+// Solidity: function baseToken(uint256 _chainId) view returns(address)
+func (_IBridgehub *IBridgehubCallerSession) BaseToken(_chainId *big.Int) (common.Address, error) {
+	return _IBridgehub.Contract.BaseToken(&_IBridgehub.CallOpts, _chainId)
+}
+
+// GetHyperchain is a free data retrieval call binding the contract method 0xdead6f7f.
+//
+// Solidity: function getHyperchain(uint256 _chainId) view returns(address)
+func (_IBridgehub *IBridgehubCaller) GetHyperchain(opts *bind.CallOpts, _chainId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _IBridgehub.contract.Call(opts, &out, "getHyperchain", _chainId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetHyperchain is a free data retrieval call binding the contract method 0xdead6f7f.
+//
+// Solidity: function getHyperchain(uint256 _chainId) view returns(address)
+func (_IBridgehub *IBridgehubSession) GetHyperchain(_chainId *big.Int) (common.Address, error) {
+	return _IBridgehub.Contract.GetHyperchain(&_IBridgehub.CallOpts, _chainId)
+}
+
+// GetHyperchain is a free data retrieval call binding the contract method 0xdead6f7f.
+//
+// Solidity: function getHyperchain(uint256 _chainId) view returns(address)
+func (_IBridgehub *IBridgehubCallerSession) GetHyperchain(_chainId *big.Int) (common.Address, error) {
+	return _IBridgehub.Contract.GetHyperchain(&_IBridgehub.CallOpts, _chainId)
+}
+
+// L2TransactionBaseCost is a free data retrieval call binding the contract method 0x71623274.
+//
+// Solidity: function l2TransactionBaseCost(uint256 _chainId, uint256 _gasPrice, uint256 _l2GasLimit, uint256 _l2GasPerPubdataByteLimit) view returns(uint256)
+func (_IBridgehub *IBridgehubCaller) L2TransactionBaseCost(opts *bind.CallOpts, _chainId *big.Int, _gasPrice *big.Int, _l2GasLimit *big.Int, _l2GasPerPubdataByteLimit *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridgehub.contract.Call(opts, &out, "l2TransactionBaseCost", _chainId, _gasPrice, _l2GasLimit, _l2GasPerPubdataByteLimit)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// L2TransactionBaseCost is a free data retrieval call binding the contract method 0x71623274.
+//
+// Solidity: function l2TransactionBaseCost(uint256 _chainId, uint256 _gasPrice, uint256 _l2GasLimit, uint256 _l2GasPerPubdataByteLimit) view returns(uint256)
+func (_IBridgehub *IBridgehubSession) L2TransactionBaseCost(_chainId *big.Int, _gasPrice *big.Int, _l2GasLimit *big.Int, _l2GasPerPubdataByteLimit *big.Int) (*big.Int, error) {
+	return _IBridgehub.Contract.L2TransactionBaseCost(&_IBridgehub.CallOpts, _chainId, _gasPrice, _l2GasLimit, _l2GasPerPubdataByteLimit)
+}
+
+// L2TransactionBaseCost is a free data retrieval call binding the contract method 0x71623274.
+//
+// Solidity: function l2TransactionBaseCost(uint256 _chainId, uint256 _gasPrice, uint256 _l2GasLimit, uint256 _l2GasPerPubdataByteLimit) view returns(uint256)
+func (_IBridgehub *IBridgehubCallerSession) L2TransactionBaseCost(_chainId *big.Int, _gasPrice *big.Int, _l2GasLimit *big.Int, _l2GasPerPubdataByteLimit *big.Int) (*big.Int, error) {
+	return _IBridgehub.Contract.L2TransactionBaseCost(&_IBridgehub.CallOpts, _chainId, _gasPrice, _l2GasLimit, _l2GasPerPubdataByteLimit)
+}
+
+// SharedBridge is a free data retrieval call binding the contract method 0x38720778.
+//
+// Solidity: function sharedBridge() view returns(address)
+func (_IBridgehub *IBridgehubCaller) SharedBridge(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IBridgehub.contract.Call(opts, &out, "sharedBridge")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SharedBridge is a free data retrieval call binding the contract method 0x38720778.
+//
+// Solidity: function sharedBridge() view returns(address)
+func (_IBridgehub *IBridgehubSession) SharedBridge() (common.Address, error) {
+	return _IBridgehub.Contract.SharedBridge(&_IBridgehub.CallOpts)
+}
+
+// SharedBridge is a free data retrieval call binding the contract method 0x38720778.
+//
+// Solidity: function sharedBridge() view returns(address)
+func (_IBridgehub *IBridgehubCallerSession) SharedBridge() (common.Address, error) {
+	return _IBridgehub.Contract.SharedBridge(&_IBridgehub.CallOpts)
+}
+
+// RequestL2TransactionDirect is a paid mutator transaction binding the contract method 0xd52471c1.
+//
+// Solidity: function requestL2TransactionDirect((uint256,uint256,address,uint256,bytes,uint256,uint256,bytes[],address) _request) payable returns(bytes32 canonicalTxHash)
+func (_IBridgehub *IBridgehubTransactor) RequestL2TransactionDirect(opts *bind.TransactOpts, _request L2TransactionRequestDirect) (*types.Transaction, error) {
+	return _IBridgehub.contract.Transact(opts, "requestL2TransactionDirect", _request)
+}
+
+// RequestL2TransactionDirect is a paid mutator transaction binding the contract method 0xd52471c1.
+//
+// Solidity: function requestL2TransactionDirect((uint256,uint256,address,uint256,bytes,uint256,uint256,bytes[],address) _request) payable returns(bytes32 canonicalTxHash)
+func (_IBridgehub *IBridgehubSession) RequestL2TransactionDirect(_request L2TransactionRequestDirect) (*types.Transaction, error) {
+	return _IBridgehub.Contract.RequestL2TransactionDirect(&_IBridgehub.TransactOpts, _request)
+}
+
+// RequestL2TransactionDirect is a paid mutator transaction binding the contract method 0xd52471c1.
+//
+// Solidity: function requestL2TransactionDirect((uint256,uint256,address,uint256,bytes,uint256,uint256,bytes[],address) _request) payable returns(bytes32 canonicalTxHash)
+func (_IBridgehub *IBridgehubTransactorSession) RequestL2TransactionDirect(_request L2TransactionRequestDirect) (*types.Transaction, error) {
+	return _IBridgehub.Contract.RequestL2TransactionDirect(&_IBridgehub.TransactOpts, _request)
+}
+
+// RequestL2TransactionTwoBridges is a paid mutator transaction binding the contract method 0x24fd57fb.
+//
+// Solidity: function requestL2TransactionTwoBridges((uint256,uint256,uint256,uint256,uint256,address,address,uint256,bytes) _request) payable returns(bytes32 canonicalTxHash)
+func (_IBridgehub *IBridgehubTransactor) RequestL2TransactionTwoBridges(opts *bind.TransactOpts, _request L2TransactionRequestTwoBridgesOuter) (*types.Transaction, error) {
+	return _IBridgehub.contract.Transact(opts, "requestL2TransactionTwoBridges", _request)
+}
+
+// RequestL2TransactionTwoBridges is a paid mutator transaction binding the contract method 0x24fd57fb.
+//
+// Solidity: function requestL2TransactionTwoBridges((uint256,uint256,uint256,uint256,uint256,address,address,uint256,bytes) _request) payable returns(bytes32 canonicalTxHash)
+func (_IBridgehub *IBridgehubSession) RequestL2TransactionTwoBridges(_request L2TransactionRequestTwoBridgesOuter) (*types.Transaction, error) {
+	return _IBridgehub.Contract.RequestL2TransactionTwoBridges(&_IBridgehub.TransactOpts, _request)
+}
+
+// RequestL2TransactionTwoBridges is a paid mutator transaction binding the contract method 0x24fd57fb.
+//
+// Solidity: function requestL2TransactionTwoBridges((uint256,uint256,uint256,uint256,uint256,address,address,uint256,bytes) _request) payable returns(bytes32 canonicalTxHash)
+func (_IBridgehub *IBridgehubTransactorSession) RequestL2TransactionTwoBridges(_request L2TransactionRequestTwoBridgesOuter) (*types.Transaction, error) {
+	return _IBridgehub.Contract.RequestL2TransactionTwoBridges(&_IBridgehub.TransactOpts, _request)
+}
@@ -0,0 +1,316 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package l1sharedbridge
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IL1SharedBridgeMetaData contains all meta data concerning the IL1SharedBridge contract.
+var IL1SharedBridgeMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"finalizeWithdrawal\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"_chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_l2BatchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_l2MessageIndex\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_l2TxNumberInBatch\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"_message\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"_merkleProof\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"isWithdrawalFinalized\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_l2BatchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_l2MessageIndex\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}]},{\"type\":\"function\",\"name\":\"claimFailedDeposit\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"_chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_depositSender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_l1Token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_l2TxHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"_l2BatchNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_l2MessageIndex\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_l2TxNumberInBatch\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"_merkleProof\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"l2BridgeAddress\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"function\",\"name\":\"bridgehub\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIBridgehub\"}]}]",
+}
+
+// IL1SharedBridgeABI is the input ABI used to generate the binding from.
+// Deprecated: Use IL1SharedBridgeMetaData.ABI instead.
+var IL1SharedBridgeABI = IL1SharedBridgeMetaData.ABI
+
+// IL1SharedBridge is an auto generated Go binding around an Ethereum contract.
+type IL1SharedBridge struct {
+	IL1SharedBridgeCaller     // Read-only binding to the contract
+	IL1SharedBridgeTransactor // Write-only binding to the contract
+	IL1SharedBridgeFilterer   // Log filterer for contract events
+}
+
+// IL1SharedBridgeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IL1SharedBridgeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IL1SharedBridgeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IL1SharedBridgeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IL1SharedBridgeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IL1SharedBridgeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IL1SharedBridgeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IL1SharedBridgeSession struct {
+	Contract     *IL1SharedBridge  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IL1SharedBridgeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IL1SharedBridgeCallerSession struct {
+	Contract *IL1SharedBridgeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// IL1SharedBridgeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IL1SharedBridgeTransactorSession struct {
+	Contract     *IL1SharedBridgeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// IL1SharedBridgeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IL1SharedBridgeRaw struct {
+	Contract *IL1SharedBridge // Generic contract binding to access the raw methods on
+}
+
+// IL1SharedBridgeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IL1SharedBridgeCallerRaw struct {
+	Contract *IL1SharedBridgeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IL1SharedBridgeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IL1SharedBridgeTransactorRaw struct {
+	Contract *IL1SharedBridgeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIL1SharedBridge creates a new instance of IL1SharedBridge, bound to a specific deployed contract.
+func NewIL1SharedBridge(address common.Address, backend bind.ContractBackend) (*IL1SharedBridge, error) {
+	contract, err := bindIL1SharedBridge(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IL1SharedBridge{IL1SharedBridgeCaller: IL1SharedBridgeCaller{contract: contract}, IL1SharedBridgeTransactor: IL1SharedBridgeTransactor{contract: contract}, IL1SharedBridgeFilterer: IL1SharedBridgeFilterer{contract: contract}}, nil
+}
+
+// NewIL1SharedBridgeCaller creates a new read-only instance of IL1SharedBridge, bound to a specific deployed contract.
+func NewIL1SharedBridgeCaller(address common.Address, caller bind.ContractCaller) (*IL1SharedBridgeCaller, error) {
+	contract, err := bindIL1SharedBridge(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IL1SharedBridgeCaller{contract: contract}, nil
+}
+
+// NewIL1SharedBridgeTransactor creates a new write-only instance of IL1SharedBridge, bound to a specific deployed contract.
+func NewIL1SharedBridgeTransactor(address common.Address, transactor bind.ContractTransactor) (*IL1SharedBridgeTransactor, error) {
+	contract, err := bindIL1SharedBridge(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IL1SharedBridgeTransactor{contract: contract}, nil
+}
+
+// NewIL1SharedBridgeFilterer creates a new log filterer instance of IL1SharedBridge, bound to a specific deployed contract.
+func NewIL1SharedBridgeFilterer(address common.Address, filterer bind.ContractFilterer) (*IL1SharedBridgeFilterer, error) {
+	contract, err := bindIL1SharedBridge(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IL1SharedBridgeFilterer{contract: contract}, nil
+}
+
+// bindIL1SharedBridge binds a generic wrapper to an already deployed contract.
+func bindIL1SharedBridge(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IL1SharedBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IL1SharedBridge *IL1SharedBridgeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IL1SharedBridge.Contract.IL1SharedBridgeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IL1SharedBridge *IL1SharedBridgeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IL1SharedBridge.Contract.IL1SharedBridgeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IL1SharedBridge *IL1SharedBridgeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IL1SharedBridge.Contract.IL1SharedBridgeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IL1SharedBridge *IL1SharedBridgeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IL1SharedBridge.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IL1SharedBridge *IL1SharedBridgeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IL1SharedBridge.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IL1SharedBridge *IL1SharedBridgeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IL1SharedBridge.Contract.contract.Transact(opts, method, params...)
+}
+
+// Bridgehub is a free data retrieval call binding the contract method 0x5d38962d.
+//
+// Solidity: function bridgehub() view returns(address)
+func (_IL1SharedBridge *IL1SharedBridgeCaller) Bridgehub(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IL1SharedBridge.contract.Call(opts, &out, "bridgehub")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Bridgehub is a free data retrieval call binding the contract method 0x5d38962d.
+//
+// Solidity: function bridgehub() view returns(address)
+func (_IL1SharedBridge *IL1SharedBridgeSession) Bridgehub() (common.Address, error) {
+	return _IL1SharedBridge.Contract.Bridgehub(&_IL1SharedBridge.CallOpts)
+}
+
+// Bridgehub is a free data retrieval call binding the contract method 0x5d38962d.
+//
+// Solidity: function bridgehub() view returns(address)
+func (_IL1SharedBridge *IL1SharedBridgeCallerSession) Bridgehub() (common.Address, error) {
+	return _IL1SharedBridge.Contract.Bridgehub(&_IL1SharedBridge.CallOpts)
+}
+
+// IsWithdrawalFinalized is a free data retrieval call binding the contract method 0x8f31f052.
+//
+// Solidity: function isWithdrawalFinalized(uint256 _chainId, uint256 _l2BatchNumber, uint256 _l2MessageIndex) view returns(bool)
+func (_IL1SharedBridge *IL1SharedBridgeCaller) IsWithdrawalFinalized(opts *bind.CallOpts, _chainId *big.Int, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int) (bool, error) {
+	var out []interface{}
+	err := _IL1SharedBridge.contract.Call(opts, &out, "isWithdrawalFinalized", _chainId, _l2BatchNumber, _l2MessageIndex)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsWithdrawalFinalized is a free data retrieval call binding the contract method 0x8f31f052.
+//
+// Solidity: function isWithdrawalFinalized(uint256 _chainId, uint256 _l2BatchNumber, uint256 _l2MessageIndex) view returns(bool)
+func (_IL1SharedBridge *IL1SharedBridgeSession) IsWithdrawalFinalized(_chainId *big.Int, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int) (bool, error) {
+	return _IL1SharedBridge.Contract.IsWithdrawalFinalized(&_IL1SharedBridge.CallOpts, _chainId, _l2BatchNumber, _l2MessageIndex)
+}
+
+// IsWithdrawalFinalized is a free data retrieval call binding the contract method 0x8f31f052.
+//
+// Solidity: function isWithdrawalFinalized(uint256 _chainId, uint256 _l2BatchNumber, uint256 _l2MessageIndex) view returns(bool)
+func (_IL1SharedBridge *IL1SharedBridgeCallerSession) IsWithdrawalFinalized(_chainId *big.Int, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int) (bool, error) {
+	return _IL1SharedBridge.Contract.IsWithdrawalFinalized(&_IL1SharedBridge.CallOpts, _chainId, _l2BatchNumber, _l2MessageIndex)
+}
+
+// L2BridgeAddress is a free data retrieval call binding the contract method 0x07ee9355.
+//
+// Solidity: function l2BridgeAddress(uint256 _chainId) view returns(address)
+func (_IL1SharedBridge *IL1SharedBridgeCaller) L2BridgeAddress(opts *bind.CallOpts, _chainId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _IL1SharedBridge.contract.Call(opts, &out, "l2BridgeAddress", _chainId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L2BridgeAddress is a free data retrieval call binding the contract method 0x07ee9355.
+//
+// Solidity: function l2BridgeAddress(uint256 _chainId) view returns(address)
+func (_IL1SharedBridge *IL1SharedBridgeSession) L2BridgeAddress(_chainId *big.Int) (common.Address, error) {
+	return _IL1SharedBridge.Contract.L2BridgeAddress(&_IL1SharedBridge.CallOpts, _chainId)
+}
+
+// L2BridgeAddress is a free data retrieval call binding the contract method 0x07ee9355.
+//
+// Solidity: function l2BridgeAddress(uint256 _chainId) view returns(address)
+func (_IL1SharedBridge *IL1SharedBridgeCallerSession) L2BridgeAddress(_chainId *big.Int) (common.Address, error) {
+	return _IL1SharedBridge.Contract.L2BridgeAddress(&_IL1SharedBridge.CallOpts, _chainId)
+}
+
+// ClaimFailedDeposit is a paid mutator transaction binding the contract method 0xc0991525.
+//
+// Solidity: function claimFailedDeposit(uint256 _chainId, address _depositSender, address _l1Token, uint256 _amount, bytes32 _l2TxHash, uint256 _l2BatchNumber, uint256 _l2MessageIndex, uint16 _l2TxNumberInBatch, bytes32[] _merkleProof) returns()
+func (_IL1SharedBridge *IL1SharedBridgeTransactor) ClaimFailedDeposit(opts *bind.TransactOpts, _chainId *big.Int, _depositSender common.Address, _l1Token common.Address, _amount *big.Int, _l2TxHash [32]byte, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int, _l2TxNumberInBatch uint16, _merkleProof [][32]byte) (*types.Transaction, error) {
+	return _IL1SharedBridge.contract.Transact(opts, "claimFailedDeposit", _chainId, _depositSender, _l1Token, _amount, _l2TxHash, _l2BatchNumber, _l2MessageIndex, _l2TxNumberInBatch, _merkleProof)
+}
+
+// ClaimFailedDeposit is a paid mutator transaction binding the contract method 0xc0991525.
+//
+// Solidity: function claimFailedDeposit(uint256 _chainId, address _depositSender, address _l1Token, uint256 _amount, bytes32 _l2TxHash, uint256 _l2BatchNumber, uint256 _l2MessageIndex, uint16 _l2TxNumberInBatch, bytes32[] _merkleProof) returns()
+func (_IL1SharedBridge *IL1SharedBridgeSession) ClaimFailedDeposit(_chainId *big.Int, _depositSender common.Address, _l1Token common.Address, _amount *big.Int, _l2TxHash [32]byte, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int, _l2TxNumberInBatch uint16, _merkleProof [][32]byte) (*types.Transaction, error) {
+	return _IL1SharedBridge.Contract.ClaimFailedDeposit(&_IL1SharedBridge.TransactOpts, _chainId, _depositSender, _l1Token, _amount, _l2TxHash, _l2BatchNumber, _l2MessageIndex, _l2TxNumberInBatch, _merkleProof)
+}
+
+// ClaimFailedDeposit is a paid mutator transaction binding the contract method 0xc0991525.
+//
+// Solidity: function claimFailedDeposit(uint256 _chainId, address _depositSender, address _l1Token, uint256 _amount, bytes32 _l2TxHash, uint256 _l2BatchNumber, uint256 _l2MessageIndex, uint16 _l2TxNumberInBatch, bytes32[] _merkleProof) returns()
+func (_IL1SharedBridge *IL1SharedBridgeTransactorSession) ClaimFailedDeposit(_chainId *big.Int, _depositSender common.Address, _l1Token common.Address, _amount *big.Int, _l2TxHash [32]byte, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int, _l2TxNumberInBatch uint16, _merkleProof [][32]byte) (*types.Transaction, error) {
+	return _IL1SharedBridge.Contract.ClaimFailedDeposit(&_IL1SharedBridge.TransactOpts, _chainId, _depositSender, _l1Token, _amount, _l2TxHash, _l2BatchNumber, _l2MessageIndex, _l2TxNumberInBatch, _merkleProof)
+}
+
+// FinalizeWithdrawal is a paid mutator transaction binding the contract method 0xc87325f1.
+//
+// Solidity: function finalizeWithdrawal(uint256 _chainId, uint256 _l2BatchNumber, uint256 _l2MessageIndex, uint16 _l2TxNumberInBatch, bytes _message, bytes32[] _merkleProof) returns()
+func (_IL1SharedBridge *IL1SharedBridgeTransactor) FinalizeWithdrawal(opts *bind.TransactOpts, _chainId *big.Int, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int, _l2TxNumberInBatch uint16, _message []byte, _merkleProof [][32]byte) (*types.Transaction, error) {
+	return _IL1SharedBridge.contract.Transact(opts, "finalizeWithdrawal", _chainId, _l2BatchNumber, _l2MessageIndex, _l2TxNumberInBatch, _message, _merkleProof)
+}
+
+// FinalizeWithdrawal is a paid mutator transaction binding the contract method 0xc87325f1.
+//
+// Solidity: function finalizeWithdrawal(uint256 _chainId, uint256 _l2BatchNumber, uint256 _l2MessageIndex, uint16 _l2TxNumberInBatch, bytes _message, bytes32[] _merkleProof) returns()
+func (_IL1SharedBridge *IL1SharedBridgeSession) FinalizeWithdrawal(_chainId *big.Int, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int, _l2TxNumberInBatch uint16, _message []byte, _merkleProof [][32]byte) (*types.Transaction, error) {
+	return _IL1SharedBridge.Contract.FinalizeWithdrawal(&_IL1SharedBridge.TransactOpts, _chainId, _l2BatchNumber, _l2MessageIndex, _l2TxNumberInBatch, _message, _merkleProof)
+}
+
+// FinalizeWithdrawal is a paid mutator transaction binding the contract method 0xc87325f1.
+//
+// Solidity: function finalizeWithdrawal(uint256 _chainId, uint256 _l2BatchNumber, uint256 _l2MessageIndex, uint16 _l2TxNumberInBatch, bytes _message, bytes32[] _merkleProof) returns()
+func (_IL1SharedBridge *IL1SharedBridgeTransactorSession) FinalizeWithdrawal(_chainId *big.Int, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int, _l2TxNumberInBatch uint16, _message []byte, _merkleProof [][32]byte) (*types.Transaction, error) {
+	return _IL1SharedBridge.Contract.FinalizeWithdrawal(&_IL1SharedBridge.TransactOpts, _chainId, _l2BatchNumber, _l2MessageIndex, _l2TxNumberInBatch, _message, _merkleProof)
+}
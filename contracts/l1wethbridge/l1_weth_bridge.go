@@ -0,0 +1,1005 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package l1wethbridge
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IL1WethBridgeMetaData contains all meta data concerning the IL1WethBridge contract.
+var IL1WethBridgeMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"l1Token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"ClaimedFailedDeposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"l2DepositTxHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"l1Token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"DepositInitiated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"EthReceived\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"l1Token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"WithdrawalFinalized\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_depositSender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_l1Token\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"_l2TxHash\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"_l2BatchNumber\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_l2MessageIndex\",\"type\":\"uint256\"},{\"internalType\":\"uint16\",\"name\":\"_l2TxNumberInBatch\",\"type\":\"uint16\"},{\"internalType\":\"bytes32[]\",\"name\":\"_merkleProof\",\"type\":\"bytes32[]\"}],\"name\":\"claimFailedDeposit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_l2Receiver\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_l1Token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_l2TxGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_l2TxGasPerPubdataByte\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_refundRecipient\",\"type\":\"address\"}],\"name\":\"deposit\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"txHash\",\"type\":\"bytes32\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_l2BatchNumber\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_l2MessageIndex\",\"type\":\"uint256\"},{\"internalType\":\"uint16\",\"name\":\"_l2TxNumberInBatch\",\"type\":\"uint16\"},{\"internalType\":\"bytes\",\"name\":\"_message\",\"type\":\"bytes\"},{\"internalType\":\"bytes32[]\",\"name\":\"_merkleProof\",\"type\":\"bytes32[]\"}],\"name\":\"finalizeWithdrawal\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_l2BatchNumber\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_l2MessageIndex\",\"type\":\"uint256\"}],\"name\":\"isWithdrawalFinalized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"l1WethAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"l2Bridge\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_l1Token\",\"type\":\"address\"}],\"name\":\"l2TokenAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"l2WethAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// IL1WethBridgeABI is the input ABI used to generate the binding from.
+// Deprecated: Use IL1WethBridgeMetaData.ABI instead.
+var IL1WethBridgeABI = IL1WethBridgeMetaData.ABI
+
+// IL1WethBridge is an auto generated Go binding around an Ethereum contract.
+type IL1WethBridge struct {
+	IL1WethBridgeCaller     // Read-only binding to the contract
+	IL1WethBridgeTransactor // Write-only binding to the contract
+	IL1WethBridgeFilterer   // Log filterer for contract events
+}
+
+// IL1WethBridgeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IL1WethBridgeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IL1WethBridgeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IL1WethBridgeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IL1WethBridgeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IL1WethBridgeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IL1WethBridgeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IL1WethBridgeSession struct {
+	Contract     *IL1WethBridge    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IL1WethBridgeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IL1WethBridgeCallerSession struct {
+	Contract *IL1WethBridgeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// IL1WethBridgeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IL1WethBridgeTransactorSession struct {
+	Contract     *IL1WethBridgeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// IL1WethBridgeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IL1WethBridgeRaw struct {
+	Contract *IL1WethBridge // Generic contract binding to access the raw methods on
+}
+
+// IL1WethBridgeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IL1WethBridgeCallerRaw struct {
+	Contract *IL1WethBridgeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IL1WethBridgeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IL1WethBridgeTransactorRaw struct {
+	Contract *IL1WethBridgeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIL1WethBridge creates a new instance of IL1WethBridge, bound to a specific deployed contract.
+func NewIL1WethBridge(address common.Address, backend bind.ContractBackend) (*IL1WethBridge, error) {
+	contract, err := bindIL1WethBridge(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IL1WethBridge{IL1WethBridgeCaller: IL1WethBridgeCaller{contract: contract}, IL1WethBridgeTransactor: IL1WethBridgeTransactor{contract: contract}, IL1WethBridgeFilterer: IL1WethBridgeFilterer{contract: contract}}, nil
+}
+
+// NewIL1WethBridgeCaller creates a new read-only instance of IL1WethBridge, bound to a specific deployed contract.
+func NewIL1WethBridgeCaller(address common.Address, caller bind.ContractCaller) (*IL1WethBridgeCaller, error) {
+	contract, err := bindIL1WethBridge(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IL1WethBridgeCaller{contract: contract}, nil
+}
+
+// NewIL1WethBridgeTransactor creates a new write-only instance of IL1WethBridge, bound to a specific deployed contract.
+func NewIL1WethBridgeTransactor(address common.Address, transactor bind.ContractTransactor) (*IL1WethBridgeTransactor, error) {
+	contract, err := bindIL1WethBridge(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IL1WethBridgeTransactor{contract: contract}, nil
+}
+
+// NewIL1WethBridgeFilterer creates a new log filterer instance of IL1WethBridge, bound to a specific deployed contract.
+func NewIL1WethBridgeFilterer(address common.Address, filterer bind.ContractFilterer) (*IL1WethBridgeFilterer, error) {
+	contract, err := bindIL1WethBridge(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IL1WethBridgeFilterer{contract: contract}, nil
+}
+
+// bindIL1WethBridge binds a generic wrapper to an already deployed contract.
+func bindIL1WethBridge(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IL1WethBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IL1WethBridge *IL1WethBridgeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IL1WethBridge.Contract.IL1WethBridgeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IL1WethBridge *IL1WethBridgeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IL1WethBridge.Contract.IL1WethBridgeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IL1WethBridge *IL1WethBridgeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IL1WethBridge.Contract.IL1WethBridgeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IL1WethBridge *IL1WethBridgeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IL1WethBridge.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IL1WethBridge *IL1WethBridgeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IL1WethBridge.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IL1WethBridge *IL1WethBridgeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IL1WethBridge.Contract.contract.Transact(opts, method, params...)
+}
+
+// IsWithdrawalFinalized is a free data retrieval call binding the contract method 0x4bed8212.
+//
+// Solidity: function isWithdrawalFinalized(uint256 _l2BatchNumber, uint256 _l2MessageIndex) view returns(bool)
+func (_IL1WethBridge *IL1WethBridgeCaller) IsWithdrawalFinalized(opts *bind.CallOpts, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int) (bool, error) {
+	var out []interface{}
+	err := _IL1WethBridge.contract.Call(opts, &out, "isWithdrawalFinalized", _l2BatchNumber, _l2MessageIndex)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsWithdrawalFinalized is a free data retrieval call binding the contract method 0x4bed8212.
+//
+// Solidity: function isWithdrawalFinalized(uint256 _l2BatchNumber, uint256 _l2MessageIndex) view returns(bool)
+func (_IL1WethBridge *IL1WethBridgeSession) IsWithdrawalFinalized(_l2BatchNumber *big.Int, _l2MessageIndex *big.Int) (bool, error) {
+	return _IL1WethBridge.Contract.IsWithdrawalFinalized(&_IL1WethBridge.CallOpts, _l2BatchNumber, _l2MessageIndex)
+}
+
+// IsWithdrawalFinalized is a free data retrieval call binding the contract method 0x4bed8212.
+//
+// Solidity: function isWithdrawalFinalized(uint256 _l2BatchNumber, uint256 _l2MessageIndex) view returns(bool)
+func (_IL1WethBridge *IL1WethBridgeCallerSession) IsWithdrawalFinalized(_l2BatchNumber *big.Int, _l2MessageIndex *big.Int) (bool, error) {
+	return _IL1WethBridge.Contract.IsWithdrawalFinalized(&_IL1WethBridge.CallOpts, _l2BatchNumber, _l2MessageIndex)
+}
+
+// L1WethAddress is a free data retrieval call binding the contract method 0x6ace8bbb.
+//
+// Solidity: function l1WethAddress() view returns(address)
+func (_IL1WethBridge *IL1WethBridgeCaller) L1WethAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IL1WethBridge.contract.Call(opts, &out, "l1WethAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L1WethAddress is a free data retrieval call binding the contract method 0x6ace8bbb.
+//
+// Solidity: function l1WethAddress() view returns(address)
+func (_IL1WethBridge *IL1WethBridgeSession) L1WethAddress() (common.Address, error) {
+	return _IL1WethBridge.Contract.L1WethAddress(&_IL1WethBridge.CallOpts)
+}
+
+// L1WethAddress is a free data retrieval call binding the contract method 0x6ace8bbb.
+//
+// Solidity: function l1WethAddress() view returns(address)
+func (_IL1WethBridge *IL1WethBridgeCallerSession) L1WethAddress() (common.Address, error) {
+	return _IL1WethBridge.Contract.L1WethAddress(&_IL1WethBridge.CallOpts)
+}
+
+// L2Bridge is a free data retrieval call binding the contract method 0xae1f6aaf.
+//
+// Solidity: function l2Bridge() view returns(address)
+func (_IL1WethBridge *IL1WethBridgeCaller) L2Bridge(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IL1WethBridge.contract.Call(opts, &out, "l2Bridge")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L2Bridge is a free data retrieval call binding the contract method 0xae1f6aaf.
+//
+// Solidity: function l2Bridge() view returns(address)
+func (_IL1WethBridge *IL1WethBridgeSession) L2Bridge() (common.Address, error) {
+	return _IL1WethBridge.Contract.L2Bridge(&_IL1WethBridge.CallOpts)
+}
+
+// L2Bridge is a free data retrieval call binding the contract method 0xae1f6aaf.
+//
+// Solidity: function l2Bridge() view returns(address)
+func (_IL1WethBridge *IL1WethBridgeCallerSession) L2Bridge() (common.Address, error) {
+	return _IL1WethBridge.Contract.L2Bridge(&_IL1WethBridge.CallOpts)
+}
+
+// L2TokenAddress is a free data retrieval call binding the contract method 0xf5f15168.
+//
+// Solidity: function l2TokenAddress(address _l1Token) view returns(address)
+func (_IL1WethBridge *IL1WethBridgeCaller) L2TokenAddress(opts *bind.CallOpts, _l1Token common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _IL1WethBridge.contract.Call(opts, &out, "l2TokenAddress", _l1Token)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L2TokenAddress is a free data retrieval call binding the contract method 0xf5f15168.
+//
+// Solidity: function l2TokenAddress(address _l1Token) view returns(address)
+func (_IL1WethBridge *IL1WethBridgeSession) L2TokenAddress(_l1Token common.Address) (common.Address, error) {
+	return _IL1WethBridge.Contract.L2TokenAddress(&_IL1WethBridge.CallOpts, _l1Token)
+}
+
+// L2TokenAddress is a free data retrieval call binding the contract method 0xf5f15168.
+//
+// Solidity: function l2TokenAddress(address _l1Token) view returns(address)
+func (_IL1WethBridge *IL1WethBridgeCallerSession) L2TokenAddress(_l1Token common.Address) (common.Address, error) {
+	return _IL1WethBridge.Contract.L2TokenAddress(&_IL1WethBridge.CallOpts, _l1Token)
+}
+
+// L2WethAddress is a free data retrieval call binding the contract method 0xc01c79b6.
+//
+// Solidity: function l2WethAddress() view returns(address)
+func (_IL1WethBridge *IL1WethBridgeCaller) L2WethAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IL1WethBridge.contract.Call(opts, &out, "l2WethAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L2WethAddress is a free data retrieval call binding the contract method 0xc01c79b6.
+//
+// Solidity: function l2WethAddress() view returns(address)
+func (_IL1WethBridge *IL1WethBridgeSession) L2WethAddress() (common.Address, error) {
+	return _IL1WethBridge.Contract.L2WethAddress(&_IL1WethBridge.CallOpts)
+}
+
+// L2WethAddress is a free data retrieval call binding the contract method 0xc01c79b6.
+//
+// Solidity: function l2WethAddress() view returns(address)
+func (_IL1WethBridge *IL1WethBridgeCallerSession) L2WethAddress() (common.Address, error) {
+	return _IL1WethBridge.Contract.L2WethAddress(&_IL1WethBridge.CallOpts)
+}
+
+// ClaimFailedDeposit is a paid mutator transaction binding the contract method 0x19fa7f62.
+//
+// Solidity: function claimFailedDeposit(address _depositSender, address _l1Token, bytes32 _l2TxHash, uint256 _l2BatchNumber, uint256 _l2MessageIndex, uint16 _l2TxNumberInBatch, bytes32[] _merkleProof) returns()
+func (_IL1WethBridge *IL1WethBridgeTransactor) ClaimFailedDeposit(opts *bind.TransactOpts, _depositSender common.Address, _l1Token common.Address, _l2TxHash [32]byte, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int, _l2TxNumberInBatch uint16, _merkleProof [][32]byte) (*types.Transaction, error) {
+	return _IL1WethBridge.contract.Transact(opts, "claimFailedDeposit", _depositSender, _l1Token, _l2TxHash, _l2BatchNumber, _l2MessageIndex, _l2TxNumberInBatch, _merkleProof)
+}
+
+// ClaimFailedDeposit is a paid mutator transaction binding the contract method 0x19fa7f62.
+//
+// Solidity: function claimFailedDeposit(address _depositSender, address _l1Token, bytes32 _l2TxHash, uint256 _l2BatchNumber, uint256 _l2MessageIndex, uint16 _l2TxNumberInBatch, bytes32[] _merkleProof) returns()
+func (_IL1WethBridge *IL1WethBridgeSession) ClaimFailedDeposit(_depositSender common.Address, _l1Token common.Address, _l2TxHash [32]byte, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int, _l2TxNumberInBatch uint16, _merkleProof [][32]byte) (*types.Transaction, error) {
+	return _IL1WethBridge.Contract.ClaimFailedDeposit(&_IL1WethBridge.TransactOpts, _depositSender, _l1Token, _l2TxHash, _l2BatchNumber, _l2MessageIndex, _l2TxNumberInBatch, _merkleProof)
+}
+
+// ClaimFailedDeposit is a paid mutator transaction binding the contract method 0x19fa7f62.
+//
+// Solidity: function claimFailedDeposit(address _depositSender, address _l1Token, bytes32 _l2TxHash, uint256 _l2BatchNumber, uint256 _l2MessageIndex, uint16 _l2TxNumberInBatch, bytes32[] _merkleProof) returns()
+func (_IL1WethBridge *IL1WethBridgeTransactorSession) ClaimFailedDeposit(_depositSender common.Address, _l1Token common.Address, _l2TxHash [32]byte, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int, _l2TxNumberInBatch uint16, _merkleProof [][32]byte) (*types.Transaction, error) {
+	return _IL1WethBridge.Contract.ClaimFailedDeposit(&_IL1WethBridge.TransactOpts, _depositSender, _l1Token, _l2TxHash, _l2BatchNumber, _l2MessageIndex, _l2TxNumberInBatch, _merkleProof)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xe8b99b1b.
+//
+// Solidity: function deposit(address _l2Receiver, address _l1Token, uint256 _amount, uint256 _l2TxGasLimit, uint256 _l2TxGasPerPubdataByte, address _refundRecipient) payable returns(bytes32 txHash)
+func (_IL1WethBridge *IL1WethBridgeTransactor) Deposit(opts *bind.TransactOpts, _l2Receiver common.Address, _l1Token common.Address, _amount *big.Int, _l2TxGasLimit *big.Int, _l2TxGasPerPubdataByte *big.Int, _refundRecipient common.Address) (*types.Transaction, error) {
+	return _IL1WethBridge.contract.Transact(opts, "deposit", _l2Receiver, _l1Token, _amount, _l2TxGasLimit, _l2TxGasPerPubdataByte, _refundRecipient)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xe8b99b1b.
+//
+// Solidity: function deposit(address _l2Receiver, address _l1Token, uint256 _amount, uint256 _l2TxGasLimit, uint256 _l2TxGasPerPubdataByte, address _refundRecipient) payable returns(bytes32 txHash)
+func (_IL1WethBridge *IL1WethBridgeSession) Deposit(_l2Receiver common.Address, _l1Token common.Address, _amount *big.Int, _l2TxGasLimit *big.Int, _l2TxGasPerPubdataByte *big.Int, _refundRecipient common.Address) (*types.Transaction, error) {
+	return _IL1WethBridge.Contract.Deposit(&_IL1WethBridge.TransactOpts, _l2Receiver, _l1Token, _amount, _l2TxGasLimit, _l2TxGasPerPubdataByte, _refundRecipient)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xe8b99b1b.
+//
+// Solidity: function deposit(address _l2Receiver, address _l1Token, uint256 _amount, uint256 _l2TxGasLimit, uint256 _l2TxGasPerPubdataByte, address _refundRecipient) payable returns(bytes32 txHash)
+func (_IL1WethBridge *IL1WethBridgeTransactorSession) Deposit(_l2Receiver common.Address, _l1Token common.Address, _amount *big.Int, _l2TxGasLimit *big.Int, _l2TxGasPerPubdataByte *big.Int, _refundRecipient common.Address) (*types.Transaction, error) {
+	return _IL1WethBridge.Contract.Deposit(&_IL1WethBridge.TransactOpts, _l2Receiver, _l1Token, _amount, _l2TxGasLimit, _l2TxGasPerPubdataByte, _refundRecipient)
+}
+
+// FinalizeWithdrawal is a paid mutator transaction binding the contract method 0x11a2ccc1.
+//
+// Solidity: function finalizeWithdrawal(uint256 _l2BatchNumber, uint256 _l2MessageIndex, uint16 _l2TxNumberInBatch, bytes _message, bytes32[] _merkleProof) returns()
+func (_IL1WethBridge *IL1WethBridgeTransactor) FinalizeWithdrawal(opts *bind.TransactOpts, _l2BatchNumber *big.Int, _l2MessageIndex *big.Int, _l2TxNumberInBatch uint16, _message []byte, _merkleProof [][32]byte) (*types.Transaction, error) {
+	return _IL1WethBridge.contract.Transact(opts, "finalizeWithdrawal", _l2BatchNumber, _l2MessageIndex, _l2TxNumberInBatch, _message, _merkleProof)
+}
+
+// FinalizeWithdrawal is a paid mutator transaction binding the contract method 0x11a2ccc1.
+//
+// Solidity: function finalizeWithdrawal(uint256 _l2BatchNumber, uint256 _l2MessageIndex, uint16 _l2TxNumberInBatch, bytes _message, bytes32[] _merkleProof) returns()
+func (_IL1WethBridge *IL1WethBridgeSession) FinalizeWithdrawal(_l2BatchNumber *big.Int, _l2MessageIndex *big.Int, _l2TxNumberInBatch uint16, _message []byte, _merkleProof [][32]byte) (*types.Transaction, error) {
+	return _IL1WethBridge.Contract.FinalizeWithdrawal(&_IL1WethBridge.TransactOpts, _l2BatchNumber, _l2MessageIndex, _l2TxNumberInBatch, _message, _merkleProof)
+}
+
+// FinalizeWithdrawal is a paid mutator transaction binding the contract method 0x11a2ccc1.
+//
+// Solidity: function finalizeWithdrawal(uint256 _l2BatchNumber, uint256 _l2MessageIndex, uint16 _l2TxNumberInBatch, bytes _message, bytes32[] _merkleProof) returns()
+func (_IL1WethBridge *IL1WethBridgeTransactorSession) FinalizeWithdrawal(_l2BatchNumber *big.Int, _l2MessageIndex *big.Int, _l2TxNumberInBatch uint16, _message []byte, _merkleProof [][32]byte) (*types.Transaction, error) {
+	return _IL1WethBridge.Contract.FinalizeWithdrawal(&_IL1WethBridge.TransactOpts, _l2BatchNumber, _l2MessageIndex, _l2TxNumberInBatch, _message, _merkleProof)
+}
+
+// IL1WethBridgeClaimedFailedDepositIterator is returned from FilterClaimedFailedDeposit and is used to iterate over the raw logs and unpacked data for ClaimedFailedDeposit events raised by the IL1WethBridge contract.
+type IL1WethBridgeClaimedFailedDepositIterator struct {
+	Event *IL1WethBridgeClaimedFailedDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IL1WethBridgeClaimedFailedDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IL1WethBridgeClaimedFailedDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IL1WethBridgeClaimedFailedDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IL1WethBridgeClaimedFailedDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IL1WethBridgeClaimedFailedDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IL1WethBridgeClaimedFailedDeposit represents a ClaimedFailedDeposit event raised by the IL1WethBridge contract.
+type IL1WethBridgeClaimedFailedDeposit struct {
+	To      common.Address
+	L1Token common.Address
+	Amount  *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterClaimedFailedDeposit is a free log retrieval operation binding the contract event 0xbe066dc591f4a444f75176d387c3e6c775e5706d9ea9a91d11eb49030c66cf60.
+//
+// Solidity: event ClaimedFailedDeposit(address indexed to, address indexed l1Token, uint256 amount)
+func (_IL1WethBridge *IL1WethBridgeFilterer) FilterClaimedFailedDeposit(opts *bind.FilterOpts, to []common.Address, l1Token []common.Address) (*IL1WethBridgeClaimedFailedDepositIterator, error) {
+
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var l1TokenRule []interface{}
+	for _, l1TokenItem := range l1Token {
+		l1TokenRule = append(l1TokenRule, l1TokenItem)
+	}
+
+	logs, sub, err := _IL1WethBridge.contract.FilterLogs(opts, "ClaimedFailedDeposit", toRule, l1TokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IL1WethBridgeClaimedFailedDepositIterator{contract: _IL1WethBridge.contract, event: "ClaimedFailedDeposit", logs: logs, sub: sub}, nil
+}
+
+// WatchClaimedFailedDeposit is a free log subscription operation binding the contract event 0xbe066dc591f4a444f75176d387c3e6c775e5706d9ea9a91d11eb49030c66cf60.
+//
+// Solidity: event ClaimedFailedDeposit(address indexed to, address indexed l1Token, uint256 amount)
+func (_IL1WethBridge *IL1WethBridgeFilterer) WatchClaimedFailedDeposit(opts *bind.WatchOpts, sink chan<- *IL1WethBridgeClaimedFailedDeposit, to []common.Address, l1Token []common.Address) (event.Subscription, error) {
+
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var l1TokenRule []interface{}
+	for _, l1TokenItem := range l1Token {
+		l1TokenRule = append(l1TokenRule, l1TokenItem)
+	}
+
+	logs, sub, err := _IL1WethBridge.contract.WatchLogs(opts, "ClaimedFailedDeposit", toRule, l1TokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IL1WethBridgeClaimedFailedDeposit)
+				if err := _IL1WethBridge.contract.UnpackLog(event, "ClaimedFailedDeposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseClaimedFailedDeposit is a log parse operation binding the contract event 0xbe066dc591f4a444f75176d387c3e6c775e5706d9ea9a91d11eb49030c66cf60.
+//
+// Solidity: event ClaimedFailedDeposit(address indexed to, address indexed l1Token, uint256 amount)
+func (_IL1WethBridge *IL1WethBridgeFilterer) ParseClaimedFailedDeposit(log types.Log) (*IL1WethBridgeClaimedFailedDeposit, error) {
+	event := new(IL1WethBridgeClaimedFailedDeposit)
+	if err := _IL1WethBridge.contract.UnpackLog(event, "ClaimedFailedDeposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IL1WethBridgeDepositInitiatedIterator is returned from FilterDepositInitiated and is used to iterate over the raw logs and unpacked data for DepositInitiated events raised by the IL1WethBridge contract.
+type IL1WethBridgeDepositInitiatedIterator struct {
+	Event *IL1WethBridgeDepositInitiated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IL1WethBridgeDepositInitiatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IL1WethBridgeDepositInitiated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IL1WethBridgeDepositInitiated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IL1WethBridgeDepositInitiatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IL1WethBridgeDepositInitiatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IL1WethBridgeDepositInitiated represents a DepositInitiated event raised by the IL1WethBridge contract.
+type IL1WethBridgeDepositInitiated struct {
+	L2DepositTxHash [32]byte
+	From            common.Address
+	To              common.Address
+	L1Token         common.Address
+	Amount          *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterDepositInitiated is a free log retrieval operation binding the contract event 0xdd341179f4edc78148d894d0213a96d212af2cbaf223d19ef6d483bdd47ab81d.
+//
+// Solidity: event DepositInitiated(bytes32 indexed l2DepositTxHash, address indexed from, address indexed to, address l1Token, uint256 amount)
+func (_IL1WethBridge *IL1WethBridgeFilterer) FilterDepositInitiated(opts *bind.FilterOpts, l2DepositTxHash [][32]byte, from []common.Address, to []common.Address) (*IL1WethBridgeDepositInitiatedIterator, error) {
+
+	var l2DepositTxHashRule []interface{}
+	for _, l2DepositTxHashItem := range l2DepositTxHash {
+		l2DepositTxHashRule = append(l2DepositTxHashRule, l2DepositTxHashItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _IL1WethBridge.contract.FilterLogs(opts, "DepositInitiated", l2DepositTxHashRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IL1WethBridgeDepositInitiatedIterator{contract: _IL1WethBridge.contract, event: "DepositInitiated", logs: logs, sub: sub}, nil
+}
+
+// WatchDepositInitiated is a free log subscription operation binding the contract event 0xdd341179f4edc78148d894d0213a96d212af2cbaf223d19ef6d483bdd47ab81d.
+//
+// Solidity: event DepositInitiated(bytes32 indexed l2DepositTxHash, address indexed from, address indexed to, address l1Token, uint256 amount)
+func (_IL1WethBridge *IL1WethBridgeFilterer) WatchDepositInitiated(opts *bind.WatchOpts, sink chan<- *IL1WethBridgeDepositInitiated, l2DepositTxHash [][32]byte, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var l2DepositTxHashRule []interface{}
+	for _, l2DepositTxHashItem := range l2DepositTxHash {
+		l2DepositTxHashRule = append(l2DepositTxHashRule, l2DepositTxHashItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _IL1WethBridge.contract.WatchLogs(opts, "DepositInitiated", l2DepositTxHashRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IL1WethBridgeDepositInitiated)
+				if err := _IL1WethBridge.contract.UnpackLog(event, "DepositInitiated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDepositInitiated is a log parse operation binding the contract event 0xdd341179f4edc78148d894d0213a96d212af2cbaf223d19ef6d483bdd47ab81d.
+//
+// Solidity: event DepositInitiated(bytes32 indexed l2DepositTxHash, address indexed from, address indexed to, address l1Token, uint256 amount)
+func (_IL1WethBridge *IL1WethBridgeFilterer) ParseDepositInitiated(log types.Log) (*IL1WethBridgeDepositInitiated, error) {
+	event := new(IL1WethBridgeDepositInitiated)
+	if err := _IL1WethBridge.contract.UnpackLog(event, "DepositInitiated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IL1WethBridgeEthReceivedIterator is returned from FilterEthReceived and is used to iterate over the raw logs and unpacked data for EthReceived events raised by the IL1WethBridge contract.
+type IL1WethBridgeEthReceivedIterator struct {
+	Event *IL1WethBridgeEthReceived // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IL1WethBridgeEthReceivedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IL1WethBridgeEthReceived)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IL1WethBridgeEthReceived)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IL1WethBridgeEthReceivedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IL1WethBridgeEthReceivedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IL1WethBridgeEthReceived represents a EthReceived event raised by the IL1WethBridge contract.
+type IL1WethBridgeEthReceived struct {
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterEthReceived is a free log retrieval operation binding the contract event 0x353bcaaf167a6add95a753d39727e3d3beb865129a69a10ed774b0b899671403.
+//
+// Solidity: event EthReceived(uint256 amount)
+func (_IL1WethBridge *IL1WethBridgeFilterer) FilterEthReceived(opts *bind.FilterOpts) (*IL1WethBridgeEthReceivedIterator, error) {
+
+	logs, sub, err := _IL1WethBridge.contract.FilterLogs(opts, "EthReceived")
+	if err != nil {
+		return nil, err
+	}
+	return &IL1WethBridgeEthReceivedIterator{contract: _IL1WethBridge.contract, event: "EthReceived", logs: logs, sub: sub}, nil
+}
+
+// WatchEthReceived is a free log subscription operation binding the contract event 0x353bcaaf167a6add95a753d39727e3d3beb865129a69a10ed774b0b899671403.
+//
+// Solidity: event EthReceived(uint256 amount)
+func (_IL1WethBridge *IL1WethBridgeFilterer) WatchEthReceived(opts *bind.WatchOpts, sink chan<- *IL1WethBridgeEthReceived) (event.Subscription, error) {
+
+	logs, sub, err := _IL1WethBridge.contract.WatchLogs(opts, "EthReceived")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IL1WethBridgeEthReceived)
+				if err := _IL1WethBridge.contract.UnpackLog(event, "EthReceived", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEthReceived is a log parse operation binding the contract event 0x353bcaaf167a6add95a753d39727e3d3beb865129a69a10ed774b0b899671403.
+//
+// Solidity: event EthReceived(uint256 amount)
+func (_IL1WethBridge *IL1WethBridgeFilterer) ParseEthReceived(log types.Log) (*IL1WethBridgeEthReceived, error) {
+	event := new(IL1WethBridgeEthReceived)
+	if err := _IL1WethBridge.contract.UnpackLog(event, "EthReceived", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IL1WethBridgeWithdrawalFinalizedIterator is returned from FilterWithdrawalFinalized and is used to iterate over the raw logs and unpacked data for WithdrawalFinalized events raised by the IL1WethBridge contract.
+type IL1WethBridgeWithdrawalFinalizedIterator struct {
+	Event *IL1WethBridgeWithdrawalFinalized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IL1WethBridgeWithdrawalFinalizedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IL1WethBridgeWithdrawalFinalized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IL1WethBridgeWithdrawalFinalized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IL1WethBridgeWithdrawalFinalizedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IL1WethBridgeWithdrawalFinalizedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IL1WethBridgeWithdrawalFinalized represents a WithdrawalFinalized event raised by the IL1WethBridge contract.
+type IL1WethBridgeWithdrawalFinalized struct {
+	To      common.Address
+	L1Token common.Address
+	Amount  *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawalFinalized is a free log retrieval operation binding the contract event 0xac1b18083978656d557d6e91c88203585cfda1031bdb14538327121ef140d383.
+//
+// Solidity: event WithdrawalFinalized(address indexed to, address indexed l1Token, uint256 amount)
+func (_IL1WethBridge *IL1WethBridgeFilterer) FilterWithdrawalFinalized(opts *bind.FilterOpts, to []common.Address, l1Token []common.Address) (*IL1WethBridgeWithdrawalFinalizedIterator, error) {
+
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var l1TokenRule []interface{}
+	for _, l1TokenItem := range l1Token {
+		l1TokenRule = append(l1TokenRule, l1TokenItem)
+	}
+
+	logs, sub, err := _IL1WethBridge.contract.FilterLogs(opts, "WithdrawalFinalized", toRule, l1TokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IL1WethBridgeWithdrawalFinalizedIterator{contract: _IL1WethBridge.contract, event: "WithdrawalFinalized", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawalFinalized is a free log subscription operation binding the contract event 0xac1b18083978656d557d6e91c88203585cfda1031bdb14538327121ef140d383.
+//
+// Solidity: event WithdrawalFinalized(address indexed to, address indexed l1Token, uint256 amount)
+func (_IL1WethBridge *IL1WethBridgeFilterer) WatchWithdrawalFinalized(opts *bind.WatchOpts, sink chan<- *IL1WethBridgeWithdrawalFinalized, to []common.Address, l1Token []common.Address) (event.Subscription, error) {
+
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var l1TokenRule []interface{}
+	for _, l1TokenItem := range l1Token {
+		l1TokenRule = append(l1TokenRule, l1TokenItem)
+	}
+
+	logs, sub, err := _IL1WethBridge.contract.WatchLogs(opts, "WithdrawalFinalized", toRule, l1TokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IL1WethBridgeWithdrawalFinalized)
+				if err := _IL1WethBridge.contract.UnpackLog(event, "WithdrawalFinalized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawalFinalized is a log parse operation binding the contract event 0xac1b18083978656d557d6e91c88203585cfda1031bdb14538327121ef140d383.
+//
+// Solidity: event WithdrawalFinalized(address indexed to, address indexed l1Token, uint256 amount)
+func (_IL1WethBridge *IL1WethBridgeFilterer) ParseWithdrawalFinalized(log types.Log) (*IL1WethBridgeWithdrawalFinalized, error) {
+	event := new(IL1WethBridgeWithdrawalFinalized)
+	if err := _IL1WethBridge.contract.UnpackLog(event, "WithdrawalFinalized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,378 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package l2wethbridge
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IL2WethBridgeMetaData contains all meta data concerning the IL2WethBridge contract.
+var IL2WethBridgeMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_l1Sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_l2Receiver\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_l1Token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"finalizeDeposit\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"l1Bridge\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_l2Token\",\"type\":\"address\"}],\"name\":\"l1TokenAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"l1WethAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_l1Token\",\"type\":\"address\"}],\"name\":\"l2TokenAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"l2WethAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_l1Receiver\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_l2Token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"withdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// IL2WethBridgeABI is the input ABI used to generate the binding from.
+// Deprecated: Use IL2WethBridgeMetaData.ABI instead.
+var IL2WethBridgeABI = IL2WethBridgeMetaData.ABI
+
+// IL2WethBridge is an auto generated Go binding around an Ethereum contract.
+type IL2WethBridge struct {
+	IL2WethBridgeCaller     // Read-only binding to the contract
+	IL2WethBridgeTransactor // Write-only binding to the contract
+	IL2WethBridgeFilterer   // Log filterer for contract events
+}
+
+// IL2WethBridgeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IL2WethBridgeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IL2WethBridgeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IL2WethBridgeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IL2WethBridgeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IL2WethBridgeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IL2WethBridgeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IL2WethBridgeSession struct {
+	Contract     *IL2WethBridge    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IL2WethBridgeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IL2WethBridgeCallerSession struct {
+	Contract *IL2WethBridgeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// IL2WethBridgeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IL2WethBridgeTransactorSession struct {
+	Contract     *IL2WethBridgeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// IL2WethBridgeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IL2WethBridgeRaw struct {
+	Contract *IL2WethBridge // Generic contract binding to access the raw methods on
+}
+
+// IL2WethBridgeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IL2WethBridgeCallerRaw struct {
+	Contract *IL2WethBridgeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IL2WethBridgeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IL2WethBridgeTransactorRaw struct {
+	Contract *IL2WethBridgeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIL2WethBridge creates a new instance of IL2WethBridge, bound to a specific deployed contract.
+func NewIL2WethBridge(address common.Address, backend bind.ContractBackend) (*IL2WethBridge, error) {
+	contract, err := bindIL2WethBridge(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IL2WethBridge{IL2WethBridgeCaller: IL2WethBridgeCaller{contract: contract}, IL2WethBridgeTransactor: IL2WethBridgeTransactor{contract: contract}, IL2WethBridgeFilterer: IL2WethBridgeFilterer{contract: contract}}, nil
+}
+
+// NewIL2WethBridgeCaller creates a new read-only instance of IL2WethBridge, bound to a specific deployed contract.
+func NewIL2WethBridgeCaller(address common.Address, caller bind.ContractCaller) (*IL2WethBridgeCaller, error) {
+	contract, err := bindIL2WethBridge(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IL2WethBridgeCaller{contract: contract}, nil
+}
+
+// NewIL2WethBridgeTransactor creates a new write-only instance of IL2WethBridge, bound to a specific deployed contract.
+func NewIL2WethBridgeTransactor(address common.Address, transactor bind.ContractTransactor) (*IL2WethBridgeTransactor, error) {
+	contract, err := bindIL2WethBridge(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IL2WethBridgeTransactor{contract: contract}, nil
+}
+
+// NewIL2WethBridgeFilterer creates a new log filterer instance of IL2WethBridge, bound to a specific deployed contract.
+func NewIL2WethBridgeFilterer(address common.Address, filterer bind.ContractFilterer) (*IL2WethBridgeFilterer, error) {
+	contract, err := bindIL2WethBridge(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IL2WethBridgeFilterer{contract: contract}, nil
+}
+
+// bindIL2WethBridge binds a generic wrapper to an already deployed contract.
+func bindIL2WethBridge(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IL2WethBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IL2WethBridge *IL2WethBridgeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IL2WethBridge.Contract.IL2WethBridgeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IL2WethBridge *IL2WethBridgeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IL2WethBridge.Contract.IL2WethBridgeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IL2WethBridge *IL2WethBridgeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IL2WethBridge.Contract.IL2WethBridgeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IL2WethBridge *IL2WethBridgeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IL2WethBridge.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IL2WethBridge *IL2WethBridgeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IL2WethBridge.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IL2WethBridge *IL2WethBridgeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IL2WethBridge.Contract.contract.Transact(opts, method, params...)
+}
+
+// L1Bridge is a free data retrieval call binding the contract method 0x969b53da.
+//
+// Solidity: function l1Bridge() view returns(address)
+func (_IL2WethBridge *IL2WethBridgeCaller) L1Bridge(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IL2WethBridge.contract.Call(opts, &out, "l1Bridge")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L1Bridge is a free data retrieval call binding the contract method 0x969b53da.
+//
+// Solidity: function l1Bridge() view returns(address)
+func (_IL2WethBridge *IL2WethBridgeSession) L1Bridge() (common.Address, error) {
+	return _IL2WethBridge.Contract.L1Bridge(&_IL2WethBridge.CallOpts)
+}
+
+// L1Bridge is a free data retrieval call binding the contract method 0x969b53da.
+//
+// Solidity: function l1Bridge() view returns(address)
+func (_IL2WethBridge *IL2WethBridgeCallerSession) L1Bridge() (common.Address, error) {
+	return _IL2WethBridge.Contract.L1Bridge(&_IL2WethBridge.CallOpts)
+}
+
+// L1TokenAddress is a free data retrieval call binding the contract method 0xf54266a2.
+//
+// Solidity: function l1TokenAddress(address _l2Token) view returns(address)
+func (_IL2WethBridge *IL2WethBridgeCaller) L1TokenAddress(opts *bind.CallOpts, _l2Token common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _IL2WethBridge.contract.Call(opts, &out, "l1TokenAddress", _l2Token)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L1TokenAddress is a free data retrieval call binding the contract method 0xf54266a2.
+//
+// Solidity: function l1TokenAddress(address _l2Token) view returns(address)
+func (_IL2WethBridge *IL2WethBridgeSession) L1TokenAddress(_l2Token common.Address) (common.Address, error) {
+	return _IL2WethBridge.Contract.L1TokenAddress(&_IL2WethBridge.CallOpts, _l2Token)
+}
+
+// L1TokenAddress is a free data retrieval call binding the contract method 0xf54266a2.
+//
+// Solidity: function l1TokenAddress(address _l2Token) view returns(address)
+func (_IL2WethBridge *IL2WethBridgeCallerSession) L1TokenAddress(_l2Token common.Address) (common.Address, error) {
+	return _IL2WethBridge.Contract.L1TokenAddress(&_IL2WethBridge.CallOpts, _l2Token)
+}
+
+// L1WethAddress is a free data retrieval call binding the contract method 0x6ace8bbb.
+//
+// Solidity: function l1WethAddress() view returns(address)
+func (_IL2WethBridge *IL2WethBridgeCaller) L1WethAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IL2WethBridge.contract.Call(opts, &out, "l1WethAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L1WethAddress is a free data retrieval call binding the contract method 0x6ace8bbb.
+//
+// Solidity: function l1WethAddress() view returns(address)
+func (_IL2WethBridge *IL2WethBridgeSession) L1WethAddress() (common.Address, error) {
+	return _IL2WethBridge.Contract.L1WethAddress(&_IL2WethBridge.CallOpts)
+}
+
+// L1WethAddress is a free data retrieval call binding the contract method 0x6ace8bbb.
+//
+// Solidity: function l1WethAddress() view returns(address)
+func (_IL2WethBridge *IL2WethBridgeCallerSession) L1WethAddress() (common.Address, error) {
+	return _IL2WethBridge.Contract.L1WethAddress(&_IL2WethBridge.CallOpts)
+}
+
+// L2TokenAddress is a free data retrieval call binding the contract method 0xf5f15168.
+//
+// Solidity: function l2TokenAddress(address _l1Token) view returns(address)
+func (_IL2WethBridge *IL2WethBridgeCaller) L2TokenAddress(opts *bind.CallOpts, _l1Token common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _IL2WethBridge.contract.Call(opts, &out, "l2TokenAddress", _l1Token)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L2TokenAddress is a free data retrieval call binding the contract method 0xf5f15168.
+//
+// Solidity: function l2TokenAddress(address _l1Token) view returns(address)
+func (_IL2WethBridge *IL2WethBridgeSession) L2TokenAddress(_l1Token common.Address) (common.Address, error) {
+	return _IL2WethBridge.Contract.L2TokenAddress(&_IL2WethBridge.CallOpts, _l1Token)
+}
+
+// L2TokenAddress is a free data retrieval call binding the contract method 0xf5f15168.
+//
+// Solidity: function l2TokenAddress(address _l1Token) view returns(address)
+func (_IL2WethBridge *IL2WethBridgeCallerSession) L2TokenAddress(_l1Token common.Address) (common.Address, error) {
+	return _IL2WethBridge.Contract.L2TokenAddress(&_IL2WethBridge.CallOpts, _l1Token)
+}
+
+// L2WethAddress is a free data retrieval call binding the contract method 0xc01c79b6.
+//
+// Solidity: function l2WethAddress() view returns(address)
+func (_IL2WethBridge *IL2WethBridgeCaller) L2WethAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IL2WethBridge.contract.Call(opts, &out, "l2WethAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L2WethAddress is a free data retrieval call binding the contract method 0xc01c79b6.
+//
+// Solidity: function l2WethAddress() view returns(address)
+func (_IL2WethBridge *IL2WethBridgeSession) L2WethAddress() (common.Address, error) {
+	return _IL2WethBridge.Contract.L2WethAddress(&_IL2WethBridge.CallOpts)
+}
+
+// L2WethAddress is a free data retrieval call binding the contract method 0xc01c79b6.
+//
+// Solidity: function l2WethAddress() view returns(address)
+func (_IL2WethBridge *IL2WethBridgeCallerSession) L2WethAddress() (common.Address, error) {
+	return _IL2WethBridge.Contract.L2WethAddress(&_IL2WethBridge.CallOpts)
+}
+
+// FinalizeDeposit is a paid mutator transaction binding the contract method 0xcfe7af7c.
+//
+// Solidity: function finalizeDeposit(address _l1Sender, address _l2Receiver, address _l1Token, uint256 _amount, bytes _data) payable returns()
+func (_IL2WethBridge *IL2WethBridgeTransactor) FinalizeDeposit(opts *bind.TransactOpts, _l1Sender common.Address, _l2Receiver common.Address, _l1Token common.Address, _amount *big.Int, _data []byte) (*types.Transaction, error) {
+	return _IL2WethBridge.contract.Transact(opts, "finalizeDeposit", _l1Sender, _l2Receiver, _l1Token, _amount, _data)
+}
+
+// FinalizeDeposit is a paid mutator transaction binding the contract method 0xcfe7af7c.
+//
+// Solidity: function finalizeDeposit(address _l1Sender, address _l2Receiver, address _l1Token, uint256 _amount, bytes _data) payable returns()
+func (_IL2WethBridge *IL2WethBridgeSession) FinalizeDeposit(_l1Sender common.Address, _l2Receiver common.Address, _l1Token common.Address, _amount *big.Int, _data []byte) (*types.Transaction, error) {
+	return _IL2WethBridge.Contract.FinalizeDeposit(&_IL2WethBridge.TransactOpts, _l1Sender, _l2Receiver, _l1Token, _amount, _data)
+}
+
+// FinalizeDeposit is a paid mutator transaction binding the contract method 0xcfe7af7c.
+//
+// Solidity: function finalizeDeposit(address _l1Sender, address _l2Receiver, address _l1Token, uint256 _amount, bytes _data) payable returns()
+func (_IL2WethBridge *IL2WethBridgeTransactorSession) FinalizeDeposit(_l1Sender common.Address, _l2Receiver common.Address, _l1Token common.Address, _amount *big.Int, _data []byte) (*types.Transaction, error) {
+	return _IL2WethBridge.Contract.FinalizeDeposit(&_IL2WethBridge.TransactOpts, _l1Sender, _l2Receiver, _l1Token, _amount, _data)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xd9caed12.
+//
+// Solidity: function withdraw(address _l1Receiver, address _l2Token, uint256 _amount) returns()
+func (_IL2WethBridge *IL2WethBridgeTransactor) Withdraw(opts *bind.TransactOpts, _l1Receiver common.Address, _l2Token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _IL2WethBridge.contract.Transact(opts, "withdraw", _l1Receiver, _l2Token, _amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xd9caed12.
+//
+// Solidity: function withdraw(address _l1Receiver, address _l2Token, uint256 _amount) returns()
+func (_IL2WethBridge *IL2WethBridgeSession) Withdraw(_l1Receiver common.Address, _l2Token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _IL2WethBridge.Contract.Withdraw(&_IL2WethBridge.TransactOpts, _l1Receiver, _l2Token, _amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xd9caed12.
+//
+// Solidity: function withdraw(address _l1Receiver, address _l2Token, uint256 _amount) returns()
+func (_IL2WethBridge *IL2WethBridgeTransactorSession) Withdraw(_l1Receiver common.Address, _l2Token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _IL2WethBridge.Contract.Withdraw(&_IL2WethBridge.TransactOpts, _l1Receiver, _l2Token, _amount)
+}
@@ -1,8 +1,10 @@
 package eip712
 
 import (
+	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"math/big"
 )
@@ -66,3 +68,40 @@ func ZkSyncEraEIP712Domain(chainId int64) *Domain {
 		VerifyingContract: nil,
 	}
 }
+
+// BuildTypedData returns the typed data within the given domain in the representation defined by EIP-712,
+// which is suitable for signing services such as Clef.
+func BuildTypedData(domain *Domain, data TypedData) (apitypes.TypedData, error) {
+	eip712Msg, err := data.EIP712Message()
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			data.EIP712Type():   data.EIP712Types(),
+			domain.EIP712Type(): domain.EIP712Types(),
+		},
+		PrimaryType: data.EIP712Type(),
+		Domain:      domain.EIP712Domain(),
+		Message:     eip712Msg,
+	}, nil
+}
+
+// HashTypedData returns the hash of the typed data within the given domain as defined by EIP-712.
+// This is the digest that is signed when signing the typed data.
+func HashTypedData(domain *Domain, data TypedData) ([]byte, error) {
+	typedData, err := BuildTypedData(domain, data)
+	if err != nil {
+		return nil, err
+	}
+	domainHash, err := typedData.HashStruct(domain.EIP712Type(), typedData.Domain.Map())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hash of typed data domain: %w", err)
+	}
+	dataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hash of typed message: %w", err)
+	}
+	prefixedData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainHash), string(dataHash)))
+	return crypto.Keccak256(prefixedData), nil
+}
@@ -77,14 +77,14 @@ func TestIntegration_ApprovalPaymaster(t *testing.T) {
 	assert.NotNil(t, paymasterAddress, "Contract should be deployed")
 
 	// ===== Transfer some ETH to paymaster, so it can pay fee with ETH =====
-	transferTx, err := wallet.Transfer(nil, accounts.TransferTransaction{
+	transferHash, err := wallet.Transfer(nil, accounts.TransferTransaction{
 		To:     paymasterAddress,
 		Amount: big.NewInt(2_000_000_000_000_000_000),
 		Token:  utils.EthAddress,
 	})
 	assert.NoError(t, err, "Transfer should not return an error")
 
-	_, err = client.WaitMined(context.Background(), transferTx.Hash())
+	_, err = client.WaitMined(context.Background(), transferHash)
 	assert.NoError(t, err, "client.WaitMined should not return an error")
 
 	// Read token and ETH balances from user and paymaster accounts
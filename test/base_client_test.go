@@ -741,14 +741,14 @@ func TestIntegrationBaseClient_WaitMined(t *testing.T) {
 	w, err := accounts.NewWallet(common.Hex2Bytes(PrivateKey), &client, nil)
 	assert.NoError(t, err, "NewWallet should not return an error")
 
-	tx, err := w.Transfer(nil, accounts.TransferTransaction{
+	hash, err := w.Transfer(nil, accounts.TransferTransaction{
 		To:     Receiver,
 		Amount: big.NewInt(7_000_000_000),
 		Token:  utils.EthAddress,
 	})
 	assert.NoError(t, err, "Transfer should not return an error")
 
-	txReceipt, err := client.WaitMined(context.Background(), tx.Hash())
+	txReceipt, err := client.WaitMined(context.Background(), hash)
 	assert.NoError(t, err, "client.WaitMined should not return an error")
 
 	assert.NotNil(t, txReceipt.BlockHash, "Transaction should be mined")
@@ -762,14 +762,14 @@ func TestIntegrationBaseClient_WaitFinalized(t *testing.T) {
 	w, err := accounts.NewWallet(common.Hex2Bytes(PrivateKey), &client, nil)
 	assert.NoError(t, err, "NewWallet should not return an error")
 
-	tx, err := w.Transfer(nil, accounts.TransferTransaction{
+	hash, err := w.Transfer(nil, accounts.TransferTransaction{
 		To:     Receiver,
 		Amount: big.NewInt(7_000_000_000),
 		Token:  utils.EthAddress,
 	})
 	assert.NoError(t, err, "Transfer should not return an error")
 
-	txReceipt, err := client.WaitFinalized(context.Background(), tx.Hash())
+	txReceipt, err := client.WaitFinalized(context.Background(), hash)
 	assert.NoError(t, err, "client.WaitMined should not return an error")
 
 	assert.NotNil(t, txReceipt.BlockHash, "Transaction should be mined")
@@ -45,7 +45,7 @@ func createTokenL2(wallet *accounts.Wallet, client clients.Client, ethClient *et
 		log.Fatal(err)
 	}
 
-	_, err = bind.WaitMined(context.Background(), ethClient, tx)
+	_, err = bind.WaitMined(context.Background(), ethClient, tx.Transaction)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -215,7 +215,7 @@ func TestIntegrationWallet_DepositETH(t *testing.T) {
 	})
 	assert.NoError(t, err, "Deposit should not return an error")
 
-	l1Receipt, err := bind.WaitMined(context.Background(), ethClient, tx)
+	l1Receipt, err := bind.WaitMined(context.Background(), ethClient, tx.Transaction)
 	assert.NoError(t, err, "bind.WaitMined should not return an error")
 
 	l2Tx, err := client.L2TransactionFromPriorityOp(context.Background(), l1Receipt)
@@ -264,7 +264,7 @@ func TestIntegrationWallet_DepositToken(t *testing.T) {
 	})
 	assert.NoError(t, err, "Deposit should not return an error")
 
-	l1Receipt, err := bind.WaitMined(context.Background(), ethClient, tx)
+	l1Receipt, err := bind.WaitMined(context.Background(), ethClient, tx.Transaction)
 	assert.NoError(t, err, "bind.WaitMined should not return an error")
 
 	l2Tx, err := client.L2TransactionFromPriorityOp(context.Background(), l1Receipt)
@@ -646,14 +646,14 @@ func TestIntegrationWallet_Transfer(t *testing.T) {
 	balanceBeforeTransferReceiver, err := client.BalanceAt(context.Background(), Receiver, nil)
 	assert.NoError(t, err, "BalanceAt should not return an error")
 
-	tx, err := wallet.Transfer(nil, accounts.TransferTransaction{
+	hash, err := wallet.Transfer(nil, accounts.TransferTransaction{
 		To:     Receiver,
 		Amount: amount,
 		Token:  utils.EthAddress,
 	})
 	assert.NoError(t, err, "Transfer should not return an error")
 
-	receipt, err := client.WaitMined(context.Background(), tx.Hash())
+	receipt, err := client.WaitMined(context.Background(), hash)
 	assert.NoError(t, err, "client.WaitMined should not return an error")
 	assert.NotNil(t, receipt.BlockHash, "Transaction should be mined")
 
@@ -690,14 +690,14 @@ func TestIntegrationWallet_TransferToken(t *testing.T) {
 	balanceBeforeTransferReceiver, err := tokenContract.BalanceOf(nil, Receiver)
 	assert.NoError(t, err, "BalanceOf should not return an error")
 
-	tx, err := wallet.Transfer(nil, accounts.TransferTransaction{
+	hash, err := wallet.Transfer(nil, accounts.TransferTransaction{
 		To:     Receiver,
 		Amount: amount,
 		Token:  L2Dai,
 	})
 	assert.NoError(t, err, "Transfer should not return an error")
 
-	receipt, err := client.WaitMined(context.Background(), tx.Hash())
+	receipt, err := client.WaitMined(context.Background(), hash)
 	assert.NoError(t, err, "client.WaitMined should not return an error")
 	assert.NotNil(t, receipt.BlockHash, "Transaction should be mined")
 
@@ -3,25 +3,31 @@ package types
 import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/zksync-sdk/zksync2-go/contracts/l1bridge"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1wethbridge"
 	"github.com/zksync-sdk/zksync2-go/contracts/l2bridge"
+	"github.com/zksync-sdk/zksync2-go/contracts/l2wethbridge"
 )
 
 // BridgeContracts represents the addresses of default bridge contracts for both L1 and L2.
 type BridgeContracts struct {
-	L1Erc20DefaultBridge common.Address `json:"l1Erc20DefaultBridge"` // Default L1Bridge contract address.
-	L2Erc20DefaultBridge common.Address `json:"l2Erc20DefaultBridge"` // Default L2Bridge contract address.
-	L1WethBridge         common.Address `json:"l1WethBridge"`         //  WETH L1Bridge contract address
-	L2WethBridge         common.Address `json:"l2WethBridge"`         //  WETH L2Bridge contract address
+	L1Erc20DefaultBridge common.Address `json:"l1Erc20DefaultBridge"`  // Default L1Bridge contract address.
+	L2Erc20DefaultBridge common.Address `json:"l2Erc20DefaultBridge"`  // Default L2Bridge contract address.
+	L1WethBridge         common.Address `json:"l1WethBridge"`          //  WETH L1Bridge contract address
+	L2WethBridge         common.Address `json:"l2WethBridge"`          //  WETH L2Bridge contract address
+	L1SharedBridge       common.Address `json:"l1SharedDefaultBridge"` // Shared L1Bridge contract address, zero if not deployed.
+	L2SharedBridge       common.Address `json:"l2SharedDefaultBridge"` // Shared L2Bridge contract address, zero if not deployed.
 }
 
 // L1BridgeContracts represents the L1 bridge contracts.
 type L1BridgeContracts struct {
-	Erc20 *l1bridge.IL1Bridge // Default L1Bridge contract.
+	Erc20 *l1bridge.IL1Bridge         // Default L1Bridge contract.
+	Weth  *l1wethbridge.IL1WethBridge // WETH L1Bridge contract, nil if the WETH bridge is not deployed.
 }
 
 // L2BridgeContracts represents the L2 bridge contracts.
 type L2BridgeContracts struct {
-	Erc20 *l2bridge.IL2Bridge // Default L2Bridge contract.
+	Erc20 *l2bridge.IL2Bridge         // Default L2Bridge contract.
+	Weth  *l2wethbridge.IL2WethBridge // WETH L2Bridge contract, nil if the WETH bridge is not deployed.
 }
 
 // AccountAbstractionVersion represents an enumeration of account abstraction versions.
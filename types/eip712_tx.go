@@ -8,8 +8,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/zksync-sdk/zksync2-go/eip712"
 	"math/big"
 )
 
@@ -35,6 +37,56 @@ type Transaction712 struct {
 	Meta    *Eip712Meta     // EIP-712 metadata.
 }
 
+// transaction712JSON is the JSON representation of Transaction712.
+type transaction712JSON struct {
+	Nonce      *hexutil.Big     `json:"nonce"`
+	GasTipCap  *hexutil.Big     `json:"maxPriorityFeePerGas"`
+	GasFeeCap  *hexutil.Big     `json:"maxFeePerGas"`
+	Gas        *hexutil.Big     `json:"gas"`
+	To         *common.Address  `json:"to"`
+	Value      *hexutil.Big     `json:"value"`
+	Data       hexutil.Bytes    `json:"data"`
+	AccessList types.AccessList `json:"accessList,omitempty"`
+	ChainID    *hexutil.Big     `json:"chainId"`
+	From       *common.Address  `json:"from"`
+	Meta       *Eip712Meta      `json:"eip712Meta"`
+}
+
+func (tx *Transaction712) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&transaction712JSON{
+		Nonce:      (*hexutil.Big)(tx.Nonce),
+		GasTipCap:  (*hexutil.Big)(tx.GasTipCap),
+		GasFeeCap:  (*hexutil.Big)(tx.GasFeeCap),
+		Gas:        (*hexutil.Big)(tx.Gas),
+		To:         tx.To,
+		Value:      (*hexutil.Big)(tx.Value),
+		Data:       tx.Data,
+		AccessList: tx.AccessList,
+		ChainID:    (*hexutil.Big)(tx.ChainID),
+		From:       tx.From,
+		Meta:       tx.Meta,
+	})
+}
+
+func (tx *Transaction712) UnmarshalJSON(input []byte) error {
+	var dec transaction712JSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	tx.Nonce = dec.Nonce.ToInt()
+	tx.GasTipCap = dec.GasTipCap.ToInt()
+	tx.GasFeeCap = dec.GasFeeCap.ToInt()
+	tx.Gas = dec.Gas.ToInt()
+	tx.To = dec.To
+	tx.Value = dec.Value.ToInt()
+	tx.Data = dec.Data
+	tx.AccessList = dec.AccessList
+	tx.ChainID = dec.ChainID.ToInt()
+	tx.From = dec.From
+	tx.Meta = dec.Meta
+	return nil
+}
+
 func (tx *Transaction712) RLPValues(sig []byte) ([]byte, error) {
 	// use custom struct to get right RLP sequence and types to use default rlp encoder
 	txRLP := struct {
@@ -87,6 +139,125 @@ func (tx *Transaction712) RLPValues(sig []byte) ([]byte, error) {
 	return append([]byte{0x71}, res...), nil
 }
 
+// DecodeTransaction712 decodes the raw signed EIP-712 transaction, such as one produced by
+// Transaction712.RLPValues, and returns the transaction along with its signature.
+// The custom signature stored in the transaction is kept in Eip712Meta.CustomSignature, so that
+// encoding the decoded transaction again yields the same raw bytes.
+func DecodeTransaction712(raw []byte) (*Transaction712, []byte, error) {
+	if len(raw) == 0 || raw[0] != 0x71 {
+		return nil, nil, errors.New("not an EIP-712 transaction")
+	}
+	var txRLP struct {
+		Nonce                uint64
+		MaxPriorityFeePerGas *big.Int
+		MaxFeePerGas         *big.Int
+		GasLimit             *big.Int
+		To                   *common.Address `rlp:"nil"`
+		Value                *big.Int
+		Data                 hexutil.Bytes
+		// zkSync part
+		V       *big.Int // legacy chain ID or signature y-parity
+		R       []byte   // legacy empty or signature r value
+		S       []byte   // legacy empty or signature s value
+		ChainID *big.Int
+		From    *common.Address
+		// Meta fields
+		GasPerPubdata   *big.Int
+		FactoryDeps     []hexutil.Bytes
+		CustomSignature hexutil.Bytes
+		PaymasterParams *PaymasterParams `rlp:"nil"`
+	}
+	if err := rlp.DecodeBytes(raw[1:], &txRLP); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode RLP bytes: %w", err)
+	}
+
+	tx := &Transaction712{
+		Nonce:     new(big.Int).SetUint64(txRLP.Nonce),
+		GasTipCap: txRLP.MaxPriorityFeePerGas,
+		GasFeeCap: txRLP.MaxFeePerGas,
+		Gas:       txRLP.GasLimit,
+		To:        txRLP.To,
+		Value:     txRLP.Value,
+		Data:      txRLP.Data,
+		ChainID:   txRLP.ChainID,
+		From:      txRLP.From,
+		Meta: &Eip712Meta{
+			GasPerPubdata:   (*hexutil.Big)(txRLP.GasPerPubdata),
+			CustomSignature: txRLP.CustomSignature,
+			FactoryDeps:     txRLP.FactoryDeps,
+			PaymasterParams: txRLP.PaymasterParams,
+		},
+	}
+
+	// Some encoders store the ECDSA signature in the legacy fields, otherwise
+	// the signature is the one stored as custom signature.
+	signature := []byte(txRLP.CustomSignature)
+	if len(txRLP.R) > 0 && len(txRLP.S) > 0 {
+		if len(txRLP.R) > 32 || len(txRLP.S) > 32 || txRLP.V == nil || txRLP.V.Uint64() > 1 {
+			return nil, nil, errors.New("invalid signature values")
+		}
+		signature = make([]byte, 65)
+		copy(signature[32-len(txRLP.R):32], txRLP.R)
+		copy(signature[64-len(txRLP.S):64], txRLP.S)
+		signature[64] = byte(txRLP.V.Uint64()) + 27
+	}
+	return tx, signature, nil
+}
+
+// SignedDigest returns the EIP-712 digest of the transaction, computed from the domain separator of
+// the zkSync Era domain of the transaction chain and the hash of the transaction struct.
+// This is the hash that the account signs, and it can be computed without communicating with the network.
+func (tx *Transaction712) SignedDigest() (common.Hash, error) {
+	if tx.ChainID == nil {
+		return common.Hash{}, errors.New("chain ID must be provided")
+	}
+	hash, err := eip712.HashTypedData(eip712.ZkSyncEraEIP712Domain(tx.ChainID.Int64()), tx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to get hash of typed data: %w", err)
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// Hash returns the canonical hash of the signed transaction, which is the same hash the node
+// returns once the transaction is submitted. The hash is computed from the signed digest and the
+// signature, where Eip712Meta.CustomSignature takes precedence over the provided signature.
+func (tx *Transaction712) Hash(signature []byte) (common.Hash, error) {
+	digest, err := tx.SignedDigest()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if tx.Meta != nil && len(tx.Meta.CustomSignature) > 0 {
+		signature = tx.Meta.CustomSignature
+	}
+	if len(signature) == 0 {
+		return common.Hash{}, errors.New("signature must be provided")
+	}
+	return crypto.Keccak256Hash(digest.Bytes(), crypto.Keccak256(signature)), nil
+}
+
+// RecoverSigner returns the address of the account that signed the transaction with the provided
+// ECDSA signature. The signature is verified against the EIP-712 digest of the transaction
+// within the zkSync Era domain of the transaction chain.
+func (tx *Transaction712) RecoverSigner(signature []byte) (common.Address, error) {
+	if len(signature) != 65 {
+		return common.Address{}, errors.New("invalid length of signature")
+	}
+	hash, err := tx.SignedDigest()
+	if err != nil {
+		return common.Address{}, err
+	}
+	sig := make([]byte, 65)
+	copy(sig, signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover public key: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
 func (tx *Transaction712) EIP712Type() string {
 	return "Transaction"
 }
@@ -192,6 +363,31 @@ func (m *Eip712Meta) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (m *Eip712Meta) UnmarshalJSON(input []byte) error {
+	type Alias Eip712Meta
+	dec := struct {
+		FactoryDeps []json.RawMessage `json:"factoryDeps"`
+		*Alias
+	}{
+		Alias: (*Alias)(m),
+	}
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	m.FactoryDeps = nil
+	if dec.FactoryDeps != nil {
+		m.FactoryDeps = make([]hexutil.Bytes, len(dec.FactoryDeps))
+		for i, d := range dec.FactoryDeps {
+			b, err := unmarshalBytesJSON(d)
+			if err != nil {
+				return fmt.Errorf("failed to decode factory dependency: %w", err)
+			}
+			m.FactoryDeps[i] = b
+		}
+	}
+	return nil
+}
+
 // PaymasterParams contains parameters for configuring the custom paymaster for the transaction.
 type PaymasterParams struct {
 	Paymaster      common.Address `json:"paymaster"`      // address of the paymaster
@@ -215,6 +411,43 @@ func (p *PaymasterParams) MarshalJSON() ([]byte, error) {
 	return json.Marshal(params)
 }
 
+func (p *PaymasterParams) UnmarshalJSON(input []byte) error {
+	var dec struct {
+		Paymaster      common.Address  `json:"paymaster"`
+		PaymasterInput json.RawMessage `json:"paymasterInput"`
+	}
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	paymasterInput, err := unmarshalBytesJSON(dec.PaymasterInput)
+	if err != nil {
+		return fmt.Errorf("failed to decode paymaster input: %w", err)
+	}
+	p.Paymaster = dec.Paymaster
+	p.PaymasterInput = paymasterInput
+	return nil
+}
+
+// unmarshalBytesJSON decodes bytes represented either as a hex string or as an array of numbers,
+// which is the representation used by the zkSync Era node.
+func unmarshalBytesJSON(input json.RawMessage) ([]byte, error) {
+	if len(input) == 0 || string(input) == "null" {
+		return nil, nil
+	}
+	if input[0] == '"' {
+		var b hexutil.Bytes
+		if err := json.Unmarshal(input, &b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+	var b []byte
+	if err := json.Unmarshal(input, &b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
 func hashBytecode(bytecode []byte) ([]byte, error) {
 	if len(bytecode)%32 != 0 {
 		return nil, errors.New("bytecode length in bytes must be divisible by 32")
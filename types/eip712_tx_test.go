@@ -0,0 +1,189 @@
+package types
+
+import (
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/zksync-sdk/zksync2-go/eip712"
+	"math/big"
+	"testing"
+)
+
+func newTestTransaction712() *Transaction712 {
+	to := common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618")
+	from := common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049")
+	return &Transaction712{
+		Nonce:     big.NewInt(7),
+		GasTipCap: big.NewInt(0),
+		GasFeeCap: big.NewInt(250_000_000),
+		Gas:       big.NewInt(1_000_000),
+		To:        &to,
+		Value:     big.NewInt(1_000),
+		Data:      hexutil.MustDecode("0xa9059cbb"),
+		ChainID:   big.NewInt(270),
+		From:      &from,
+		Meta: &Eip712Meta{
+			GasPerPubdata: (*hexutil.Big)(big.NewInt(50_000)),
+			FactoryDeps:   []hexutil.Bytes{make([]byte, 64)},
+			PaymasterParams: &PaymasterParams{
+				Paymaster:      common.HexToAddress("0x0a67078A35745947A37A552174aFe724D8180c25"),
+				PaymasterInput: common.Hex2Bytes("8c5a3445"),
+			},
+		},
+	}
+}
+
+func TestDecodeTransaction712(t *testing.T) {
+	key, err := crypto.HexToECDSA("7726827caac94a7f9e1b160f7ea819f172f7b6f9d2a97f992c38edeab82d4110")
+	assert.NoError(t, err, "HexToECDSA should not return error")
+
+	tx := newTestTransaction712()
+	hash, err := eip712.HashTypedData(eip712.ZkSyncEraEIP712Domain(tx.ChainID.Int64()), tx)
+	assert.NoError(t, err, "HashTypedData should not return error")
+	signature, err := crypto.Sign(hash, key)
+	assert.NoError(t, err, "Sign should not return error")
+	signature[64] += 27
+
+	raw, err := tx.RLPValues(signature)
+	assert.NoError(t, err, "RLPValues should not return error")
+
+	decoded, decodedSignature, err := DecodeTransaction712(raw)
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	assert.Equal(t, signature, decodedSignature, "Signatures should be the same")
+	assert.Equal(t, tx.Nonce, decoded.Nonce, "Nonces should be the same")
+	assert.Equal(t, tx.GasFeeCap, decoded.GasFeeCap, "Gas fee caps should be the same")
+	assert.Equal(t, tx.Gas, decoded.Gas, "Gas limits should be the same")
+	assert.Equal(t, tx.To, decoded.To, "Recipients should be the same")
+	assert.Equal(t, tx.Value, decoded.Value, "Values should be the same")
+	assert.Equal(t, tx.Data, decoded.Data, "Data should be the same")
+	assert.Equal(t, tx.ChainID, decoded.ChainID, "Chain IDs should be the same")
+	assert.Equal(t, tx.From, decoded.From, "Senders should be the same")
+	assert.Equal(t, tx.Meta.GasPerPubdata, decoded.Meta.GasPerPubdata, "Gas per pubdata should be the same")
+	assert.Equal(t, tx.Meta.FactoryDeps, decoded.Meta.FactoryDeps, "Factory deps should be the same")
+	assert.Equal(t, tx.Meta.PaymasterParams, decoded.Meta.PaymasterParams, "Paymaster params should be the same")
+
+	encoded, err := decoded.RLPValues(nil)
+	assert.NoError(t, err, "RLPValues should not return error")
+	assert.Equal(t, raw, encoded, "Raw transactions should be the same")
+
+	signer, err := decoded.RecoverSigner(decodedSignature)
+	assert.NoError(t, err, "RecoverSigner should not return error")
+	assert.Equal(t, *tx.From, signer, "Signer should be the sender of the transaction")
+}
+
+func TestDecodeTransaction712WithoutPaymaster(t *testing.T) {
+	tx := newTestTransaction712()
+	tx.Meta.PaymasterParams = nil
+	tx.Meta.FactoryDeps = nil
+
+	raw, err := tx.RLPValues(nil)
+	assert.NoError(t, err, "RLPValues should not return error")
+
+	decoded, signature, err := DecodeTransaction712(raw)
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	assert.Empty(t, signature, "Signature should be empty")
+	assert.Nil(t, decoded.Meta.PaymasterParams, "Paymaster params should be nil")
+	assert.Empty(t, decoded.Meta.FactoryDeps, "Factory deps should be empty")
+}
+
+func TestDecodeTransaction712InvalidType(t *testing.T) {
+	_, _, err := DecodeTransaction712([]byte{0x02, 0xc0})
+	assert.Error(t, err, "DecodeTransaction712 should return error for non EIP-712 transaction")
+}
+
+func TestTransaction712Hash(t *testing.T) {
+	// signed transfer of 1000 wei with the calldata 0xa9059cbb on the chain 270, and its L2 transaction hash
+	raw := hexutil.MustDecode("0x71f88d0780840ee6b280830f424094a61464658afeaf65cccaafd3a512b69a83b776188203e884" +
+		"a9059cbb82010e808082010e9436615cf349d7f6344891b1e7ca7c72883f5dc04982c350c0b841be7f3c63f737f53ec2329b82" +
+		"9f3141c5cb6bb9bf37f684ed35ba6e187ab0f60b0b6bb0dae7d38e2a1c5a2c8ef3f1157ba98840de860ea2c1cf6e6432fd5afa" +
+		"8c1bc0")
+	expectedDigest := common.HexToHash("0xdb56c0867dd9976868f518ce9a2f1dd75b8a557e893e65821af5c8526710ff0a")
+	expectedHash := common.HexToHash("0x14f150aea54874cbc06484238a302cd5d56d98a1056edea7cb8d1d4f6a572257")
+
+	// the expected values are encoded by hand as specified by EIP-712 and the zkSync Era transaction hashing,
+	// without the typed data encoding of the package
+	word := func(v int64) []byte { return common.BigToHash(big.NewInt(v)).Bytes() }
+	domainSeparator := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId)")),
+		crypto.Keccak256([]byte("zkSync")),
+		crypto.Keccak256([]byte("2")),
+		word(270),
+	)
+	structHash := crypto.Keccak256(
+		crypto.Keccak256([]byte("Transaction(uint256 txType,uint256 from,uint256 to,uint256 gasLimit,"+
+			"uint256 gasPerPubdataByteLimit,uint256 maxFeePerGas,uint256 maxPriorityFeePerGas,uint256 paymaster,"+
+			"uint256 nonce,uint256 value,bytes data,bytes32[] factoryDeps,bytes paymasterInput)")),
+		word(0x71),
+		common.LeftPadBytes(common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049").Bytes(), 32),
+		common.LeftPadBytes(common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618").Bytes(), 32),
+		word(1_000_000),
+		word(50_000),
+		word(250_000_000),
+		word(0),
+		word(0),
+		word(7),
+		word(1_000),
+		crypto.Keccak256(common.Hex2Bytes("a9059cbb")),
+		crypto.Keccak256(),
+		crypto.Keccak256(),
+	)
+	assert.Equal(t, expectedDigest, crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator, structHash),
+		"Digest should be encoded as specified by EIP-712")
+	signature := raw[len(raw)-66 : len(raw)-1]
+	assert.Equal(t, expectedHash, crypto.Keccak256Hash(expectedDigest.Bytes(), crypto.Keccak256(signature)),
+		"Hash should be the hash of the digest and the signature")
+
+	tx, decodedSignature, err := DecodeTransaction712(raw)
+	assert.NoError(t, err, "DecodeTransaction712 should not return error")
+	assert.Equal(t, signature, decodedSignature, "Signatures should be the same")
+	digest, err := tx.SignedDigest()
+	assert.NoError(t, err, "SignedDigest should not return error")
+	assert.Equal(t, expectedDigest, digest, "Digests should be the same")
+
+	hash, err := tx.Hash(decodedSignature)
+	assert.NoError(t, err, "Hash should not return error")
+	assert.Equal(t, expectedHash, hash, "Hashes should be the same")
+	hash, err = tx.Hash(nil)
+	assert.NoError(t, err, "Hash should not return error")
+	assert.Equal(t, expectedHash, hash, "Hash of decoded transaction should be the same")
+}
+
+func TestTransaction712HashWithoutSignature(t *testing.T) {
+	_, err := newTestTransaction712().Hash(nil)
+	assert.Error(t, err, "Hash should return error when signature is not provided")
+}
+
+func TestTransaction712JSON(t *testing.T) {
+	tx := newTestTransaction712()
+	tx.Meta.CustomSignature = common.Hex2Bytes("01020304")
+
+	data, err := json.Marshal(tx)
+	assert.NoError(t, err, "Marshal should not return error")
+
+	var decoded Transaction712
+	err = json.Unmarshal(data, &decoded)
+	assert.NoError(t, err, "Unmarshal should not return error")
+	assert.Equal(t, tx.Meta.FactoryDeps, decoded.Meta.FactoryDeps, "Factory deps should be the same")
+	assert.Equal(t, tx.Meta.PaymasterParams, decoded.Meta.PaymasterParams, "Paymaster params should be the same")
+	assert.Equal(t, tx.Meta.CustomSignature, decoded.Meta.CustomSignature, "Custom signatures should be the same")
+
+	expected, err := tx.RLPValues(nil)
+	assert.NoError(t, err, "RLPValues should not return error")
+	actual, err := decoded.RLPValues(nil)
+	assert.NoError(t, err, "RLPValues should not return error")
+	assert.Equal(t, expected, actual, "Raw transactions should be the same")
+}
+
+func TestEip712MetaUnmarshalJSONHexBytes(t *testing.T) {
+	var meta Eip712Meta
+	err := json.Unmarshal([]byte(`{
+		"gasPerPubdata": "0xc350",
+		"factoryDeps": ["0x0102"],
+		"paymasterParams": {"paymaster": "0x0a67078a35745947a37a552174afe724d8180c25", "paymasterInput": "0x8c5a3445"}
+	}`), &meta)
+	assert.NoError(t, err, "Unmarshal should not return error")
+	assert.Equal(t, []hexutil.Bytes{{1, 2}}, meta.FactoryDeps, "Factory deps should be the same")
+	assert.Equal(t, common.Hex2Bytes("8c5a3445"), meta.PaymasterParams.PaymasterInput, "Paymaster input should be the same")
+}
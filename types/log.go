@@ -51,6 +51,7 @@ type L2ToL1Log struct {
 	BlockHash        common.Hash    `json:"blockHash"`
 	L1BatchNumber    *hexutil.Big   `json:"l1BatchNumber"`
 	TransactionIndex *hexutil.Uint  `json:"transactionIndex"`
+	TxIndexInL1Batch *hexutil.Uint  `json:"txIndexInL1Batch"`
 	ShardId          *hexutil.Uint  `json:"shardId"`
 	IsService        bool           `json:"isService"`
 	Sender           common.Address `json:"sender"`
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+)
+
+// PriorityTxType represents the type of the priority transaction, which is submitted on L1 network
+// and executed on L2 network.
+const PriorityTxType = 0xff
+
+// PriorityTransaction represents the L1 -> L2 priority transaction as it is executed on L2 network.
+// It is decoded from the NewPriorityRequest event emitted by the main contract on L1 network.
+type PriorityTransaction struct {
+	Hash                   common.Hash    // The canonical hash of the transaction on L2 network.
+	PriorityOpId           *big.Int       // The serial number of the priority operation.
+	ExpirationTimestamp    uint64         // The timestamp until which the transaction must be processed.
+	TxType                 uint8          // The transaction type, which is always PriorityTxType.
+	From                   common.Address // The sender on L2 network, which is aliased if the sender on L1 is a contract.
+	To                     common.Address // The address of the recipient.
+	GasLimit               *big.Int       // The gas limit of the transaction on L2 network.
+	GasPerPubdataByteLimit *big.Int       // The maximum amount of L2 gas per byte of pubdata.
+	MaxFeePerGas           *big.Int       // The maximum fee per gas on L2 network.
+	MaxPriorityFeePerGas   *big.Int       // The maximum priority fee per gas, which is not used on L2 network.
+	Paymaster              common.Address // The paymaster, which is not used by priority transactions.
+	Nonce                  *big.Int       // The nonce, which equals to the PriorityOpId.
+	Value                  *big.Int       // The value passed to the recipient.
+	ToMint                 *big.Int       // The amount of ETH minted on L2 network, including the value and the fee.
+	RefundRecipient        common.Address // The recipient of the refund of the unused gas and of the value if the transaction fails.
+	Data                   []byte         // The calldata.
+	FactoryDeps            [][]byte       // The bytecodes of the contracts that are published with the transaction.
+	FactoryDepsHashes      []common.Hash  // The hashes of the factory deps as included in the transaction.
+}
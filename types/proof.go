@@ -11,11 +11,14 @@ type MessageProof struct {
 
 // StorageProof Merkle proofs for one or more storage values at the specified account
 type StorageProof struct {
-	Address string `json:"address"`
-	Proofs  []struct {
-		Key   string   `json:"key"`
-		Proof []string `json:"proof"`
-		Value string   `json:"value"`
-		Index int      `json:"index"`
-	} `json:"storageProof"`
+	Address common.Address    `json:"address"`
+	Proofs  []StorageKeyProof `json:"storageProof"`
+}
+
+// StorageKeyProof is the Merkle proof of the storage value in the sparse Merkle tree of the batch state.
+type StorageKeyProof struct {
+	Key   common.Hash   `json:"key"`   // The storage key.
+	Proof []common.Hash `json:"proof"` // The Merkle path from the root down to the leaf, omitting the trailing empty subtrees.
+	Value common.Hash   `json:"value"` // The storage value, zero if the key is not present in the tree.
+	Index uint64        `json:"index"` // The index of the leaf in the tree, zero if the key is not present in the tree.
 }
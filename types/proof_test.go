@@ -0,0 +1,31 @@
+package types
+
+import (
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestStorageProofUnmarshalJSON(t *testing.T) {
+	data := `{
+		"address": "0x0000000000000000000000000000000000008003",
+		"storageProof": [{
+			"key": "0x8b65c0cf1012ea9f393197eb24619fd814379b298b238285649e14f936a5eb12",
+			"proof": ["0xe3e8e49a998b3abf8926f62a5a832d829aadc1b7e059f1ea59ffbab8e11edfb7"],
+			"value": "0x0000000000000000000000000000000000000000000000000000000000000060",
+			"index": 27900957
+		}]
+	}`
+	var proof StorageProof
+	assert.NoError(t, json.Unmarshal([]byte(data), &proof), "Unmarshal should not return error")
+	assert.Equal(t, StorageProof{
+		Address: common.HexToAddress("0x8003"),
+		Proofs: []StorageKeyProof{{
+			Key:   common.HexToHash("0x8b65c0cf1012ea9f393197eb24619fd814379b298b238285649e14f936a5eb12"),
+			Proof: []common.Hash{common.HexToHash("0xe3e8e49a998b3abf8926f62a5a832d829aadc1b7e059f1ea59ffbab8e11edfb7")},
+			Value: common.HexToHash("0x60"),
+			Index: 27900957,
+		}},
+	}, proof, "Proofs should be the same")
+}
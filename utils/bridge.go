@@ -1,12 +1,15 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/zksync-sdk/zksync2-go/contracts/erc20"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1wethbridge"
 	"github.com/zksync-sdk/zksync2-go/contracts/l2bridge"
+	"github.com/zksync-sdk/zksync2-go/contracts/l2wethbridge"
 	"github.com/zksync-sdk/zksync2-go/types"
 	"math/big"
 )
@@ -23,7 +26,11 @@ func CreateETH() *types.Token {
 }
 
 // Erc20DefaultBridgeData Returns the data needed for correct initialization of an L1 token counterpart on L2.
+// For EthAddressInContracts, which represents ETH deposited through the shared bridge, the data of ETH is returned.
 func Erc20DefaultBridgeData(l1TokenAddress common.Address, backend bind.ContractBackend) ([]byte, error) {
+	if l1TokenAddress == EthAddressInContracts {
+		return tokenBridgeData("Ether", "ETH", 18)
+	}
 	token, err := erc20.NewIERC20(l1TokenAddress, backend)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load IERC20: %w", err)
@@ -40,7 +47,10 @@ func Erc20DefaultBridgeData(l1TokenAddress common.Address, backend bind.Contract
 	if err != nil {
 		return nil, err
 	}
+	return tokenBridgeData(name, symbol, decimals)
+}
 
+func tokenBridgeData(name, symbol string, decimals uint8) ([]byte, error) {
 	stringAbiType, err := abi.NewType("string", "", nil)
 	if err != nil {
 		return nil, err
@@ -83,3 +93,37 @@ func Erc20BridgeCalldata(l1TokenAddress, l1Sender, l2Receiver common.Address, am
 	}
 	return l2BridgeAbi.Pack("finalizeDeposit", l1Sender, l2Receiver, l1TokenAddress, amount, bridgeData)
 }
+
+// IsL1WethToken checks whether the L1 token is the WETH token that is bridged by the L1 WETH bridge.
+// It returns false if the WETH bridge is not deployed.
+func IsL1WethToken(ctx context.Context, backend bind.ContractCaller, l1WethBridge, token common.Address) (bool, error) {
+	if l1WethBridge == (common.Address{}) || token == EthAddress {
+		return false, nil
+	}
+	bridge, err := l1wethbridge.NewIL1WethBridgeCaller(l1WethBridge, backend)
+	if err != nil {
+		return false, fmt.Errorf("failed to load IL1WethBridge: %w", err)
+	}
+	l1WethAddress, err := bridge.L1WethAddress(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, fmt.Errorf("failed to get L1 WETH address: %w", err)
+	}
+	return token == l1WethAddress, nil
+}
+
+// IsL2WethToken checks whether the L2 token is the WETH token that is bridged by the L2 WETH bridge.
+// It returns false if the WETH bridge is not deployed.
+func IsL2WethToken(ctx context.Context, backend bind.ContractCaller, l2WethBridge, token common.Address) (bool, error) {
+	if l2WethBridge == (common.Address{}) || token == EthAddress {
+		return false, nil
+	}
+	bridge, err := l2wethbridge.NewIL2WethBridgeCaller(l2WethBridge, backend)
+	if err != nil {
+		return false, fmt.Errorf("failed to load IL2WethBridge: %w", err)
+	}
+	l2WethAddress, err := bridge.L2WethAddress(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, fmt.Errorf("failed to get L2 WETH address: %w", err)
+	}
+	return token == l2WethAddress, nil
+}
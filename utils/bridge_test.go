@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1wethbridge"
+	"github.com/zksync-sdk/zksync2-go/contracts/l2wethbridge"
+	"math/big"
+	"testing"
+)
+
+// wethBridgeCaller is the stand-in for the backend which serves the calls to the WETH bridge.
+type wethBridgeCaller struct {
+	bridge common.Address
+	abi    abi.ABI
+	method string
+	weth   common.Address
+	calls  int
+}
+
+func (c *wethBridgeCaller) CodeAt(_ context.Context, _ common.Address, _ *big.Int) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (c *wethBridgeCaller) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	c.calls++
+	if *call.To != c.bridge {
+		return nil, ethereum.NotFound
+	}
+	return c.abi.Methods[c.method].Outputs.Pack(c.weth)
+}
+
+func TestIsL1WethToken(t *testing.T) {
+	bridgeAbi, err := l1wethbridge.IL1WethBridgeMetaData.GetAbi()
+	assert.NoError(t, err, "GetAbi should not return error")
+	caller := &wethBridgeCaller{
+		bridge: common.HexToAddress("0x0000000000000000000000000000000000000b01"),
+		abi:    *bridgeAbi,
+		method: "l1WethAddress",
+		weth:   common.HexToAddress("0x0000000000000000000000000000000000000e01"),
+	}
+
+	isWeth, err := IsL1WethToken(context.Background(), caller, caller.bridge, caller.weth)
+	assert.NoError(t, err, "IsL1WethToken should not return error")
+	assert.True(t, isWeth, "WETH token should be bridged by WETH bridge")
+
+	isWeth, err = IsL1WethToken(context.Background(), caller, caller.bridge, common.HexToAddress("0x0d"))
+	assert.NoError(t, err, "IsL1WethToken should not return error")
+	assert.False(t, isWeth, "Other tokens should not be bridged by WETH bridge")
+
+	calls := caller.calls
+	isWeth, err = IsL1WethToken(context.Background(), caller, common.Address{}, caller.weth)
+	assert.NoError(t, err, "IsL1WethToken should not return error")
+	assert.False(t, isWeth, "Token should not be WETH if WETH bridge is not deployed")
+	isWeth, err = IsL1WethToken(context.Background(), caller, caller.bridge, EthAddress)
+	assert.NoError(t, err, "IsL1WethToken should not return error")
+	assert.False(t, isWeth, "ETH should not be bridged by WETH bridge")
+	assert.Equal(t, calls, caller.calls, "WETH bridge should not be called")
+}
+
+func TestIsL2WethToken(t *testing.T) {
+	bridgeAbi, err := l2wethbridge.IL2WethBridgeMetaData.GetAbi()
+	assert.NoError(t, err, "GetAbi should not return error")
+	caller := &wethBridgeCaller{
+		bridge: common.HexToAddress("0x0000000000000000000000000000000000000b02"),
+		abi:    *bridgeAbi,
+		method: "l2WethAddress",
+		weth:   common.HexToAddress("0x0000000000000000000000000000000000000e02"),
+	}
+
+	isWeth, err := IsL2WethToken(context.Background(), caller, caller.bridge, caller.weth)
+	assert.NoError(t, err, "IsL2WethToken should not return error")
+	assert.True(t, isWeth, "WETH token should be bridged by WETH bridge")
+
+	isWeth, err = IsL2WethToken(context.Background(), caller, caller.bridge, common.HexToAddress("0x0d"))
+	assert.NoError(t, err, "IsL2WethToken should not return error")
+	assert.False(t, isWeth, "Other tokens should not be bridged by WETH bridge")
+
+	_, err = IsL2WethToken(context.Background(), caller, common.HexToAddress("0x0b"), caller.weth)
+	assert.Error(t, err, "IsL2WethToken should return error if bridge call fails")
+}
+
+func TestErc20DefaultBridgeDataEth(t *testing.T) {
+	data, err := Erc20DefaultBridgeData(EthAddressInContracts, nil)
+	assert.NoError(t, err, "Erc20DefaultBridgeData should not return error")
+
+	bytesType, err := abi.NewType("bytes", "", nil)
+	assert.NoError(t, err, "NewType should not return error")
+	stringType, err := abi.NewType("string", "", nil)
+	assert.NoError(t, err, "NewType should not return error")
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	assert.NoError(t, err, "NewType should not return error")
+
+	fields, err := abi.Arguments{{Type: bytesType}, {Type: bytesType}, {Type: bytesType}}.Unpack(data)
+	assert.NoError(t, err, "Unpack should not return error")
+	name, err := abi.Arguments{{Type: stringType}}.Unpack(fields[0].([]byte))
+	assert.NoError(t, err, "Unpack should not return error")
+	symbol, err := abi.Arguments{{Type: stringType}}.Unpack(fields[1].([]byte))
+	assert.NoError(t, err, "Unpack should not return error")
+	decimals, err := abi.Arguments{{Type: uint256Type}}.Unpack(fields[2].([]byte))
+	assert.NoError(t, err, "Unpack should not return error")
+	assert.Equal(t, "Ether", name[0], "Names should be the same")
+	assert.Equal(t, "ETH", symbol[0], "Symbols should be the same")
+	assert.Equal(t, big.NewInt(18), decimals[0], "Decimals should be the same")
+}
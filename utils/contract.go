@@ -21,6 +21,13 @@ var (
 	L2EthTokenAddress       = common.HexToAddress("0x000000000000000000000000000000000000800a")
 	NonceHolderAddress      = common.HexToAddress("0x0000000000000000000000000000000000008003")
 
+	// EthAddressInContracts is the address used by the L1 contracts to represent ETH, for example as the base token
+	// of the chain, or as the token deposited through the shared bridge.
+	EthAddressInContracts = common.HexToAddress("0x0000000000000000000000000000000000000001")
+	// L2BaseTokenAddress is the address of the system contract that manages the base token of the chain on L2,
+	// which is the same contract that manages ETH on ETH-based chains.
+	L2BaseTokenAddress = L2EthTokenAddress
+
 	// L1ToL2AliasOffset Used for applying and undoing aliases on contract addresses during bridging from L1 to L2.
 	L1ToL2AliasOffset = common.HexToAddress("0x1111000000000000000000000000000000001111")
 	AddressModulo     = new(big.Int).Exp(big.NewInt(2), big.NewInt(160), nil)
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/zksync-sdk/zksync2-go/types"
+)
+
+// L2ToL1LogLeaf returns the leaf of the L2 -> L1 log in the Merkle tree of the L2 -> L1 logs of the batch,
+// which is computed as keccak256(abi.encodePacked(l2ShardId, isService, txNumberInBatch, sender, key, value)).
+// The log must contain the index of the transaction in the batch.
+func L2ToL1LogLeaf(log *types.L2ToL1Log) (common.Hash, error) {
+	if log.TxIndexInL1Batch == nil {
+		return common.Hash{}, errors.New("index of the transaction in the batch is not provided")
+	}
+	key, err := decodeHash(log.Key)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to decode key: %w", err)
+	}
+	value, err := decodeHash(log.Value)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to decode value: %w", err)
+	}
+	var shardId uint
+	if log.ShardId != nil {
+		shardId = uint(*log.ShardId)
+	}
+	var isService byte
+	if log.IsService {
+		isService = 1
+	}
+	txNumberInBatch := uint(*log.TxIndexInL1Batch)
+
+	packed := make([]byte, 0, 88)
+	packed = append(packed, byte(shardId), isService, byte(txNumberInBatch>>8), byte(txNumberInBatch))
+	packed = append(packed, log.Sender.Bytes()...)
+	packed = append(packed, key.Bytes()...)
+	packed = append(packed, value.Bytes()...)
+	return crypto.Keccak256Hash(packed), nil
+}
+
+// MerkleRootFromProof returns the root of the Merkle tree that contains the leaf at the specified position,
+// by folding the path of the sibling hashes from the leaf up to the root.
+func MerkleRootFromProof(leaf common.Hash, index int, proof []common.Hash) common.Hash {
+	current := leaf
+	for _, sibling := range proof {
+		if index%2 == 0 {
+			current = crypto.Keccak256Hash(current.Bytes(), sibling.Bytes())
+		} else {
+			current = crypto.Keccak256Hash(sibling.Bytes(), current.Bytes())
+		}
+		index /= 2
+	}
+	return current
+}
+
+// VerifyL2ToL1LogProof checks that the proof proves the inclusion of the L2 -> L1 log in the tree whose root
+// is provided by the proof.
+func VerifyL2ToL1LogProof(log *types.L2ToL1Log, proof *types.MessageProof) error {
+	leaf, err := L2ToL1LogLeaf(log)
+	if err != nil {
+		return err
+	}
+	if root := MerkleRootFromProof(leaf, proof.Id, proof.Proof); root != proof.Root {
+		return fmt.Errorf("computed root %s does not match proof root %s", root, proof.Root)
+	}
+	return nil
+}
+
+func decodeHash(s string) (common.Hash, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(b) > common.HashLength {
+		return common.Hash{}, fmt.Errorf("value is longer than %d bytes", common.HashLength)
+	}
+	return common.BytesToHash(b), nil
+}
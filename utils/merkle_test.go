@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/zksync-sdk/zksync2-go/types"
+	"testing"
+)
+
+func TestL2ToL1LogLeaf(t *testing.T) {
+	txIndex := hexutil.Uint(0x0102)
+	log := &types.L2ToL1Log{
+		TxIndexInL1Batch: &txIndex,
+		IsService:        true,
+		Sender:           L1MessengerAddress,
+		Key:              "0x00000000000000000000000036615cf349d7f6344891b1e7ca7c72883f5dc049",
+		Value:            crypto.Keccak256Hash([]byte("message")).Hex(),
+	}
+	leaf, err := L2ToL1LogLeaf(log)
+	assert.NoError(t, err, "L2ToL1LogLeaf should not return error")
+
+	packed := append([]byte{0x00, 0x01, 0x01, 0x02}, L1MessengerAddress.Bytes()...)
+	packed = append(packed, common.HexToHash(log.Key).Bytes()...)
+	packed = append(packed, common.HexToHash(log.Value).Bytes()...)
+	assert.Len(t, packed, 88, "Packed log should have 88 bytes")
+	assert.Equal(t, crypto.Keccak256Hash(packed), leaf, "Leaves should be the same")
+
+	log.TxIndexInL1Batch = nil
+	_, err = L2ToL1LogLeaf(log)
+	assert.Error(t, err, "L2ToL1LogLeaf should return error without transaction index")
+}
+
+func TestMerkleRootFromProof(t *testing.T) {
+	leaves := []common.Hash{
+		common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03"), common.HexToHash("0x04"),
+	}
+	left := crypto.Keccak256Hash(leaves[0].Bytes(), leaves[1].Bytes())
+	right := crypto.Keccak256Hash(leaves[2].Bytes(), leaves[3].Bytes())
+	root := crypto.Keccak256Hash(left.Bytes(), right.Bytes())
+
+	assert.Equal(t, root, MerkleRootFromProof(leaves[0], 0, []common.Hash{leaves[1], right}), "Roots should be the same")
+	assert.Equal(t, root, MerkleRootFromProof(leaves[1], 1, []common.Hash{leaves[0], right}), "Roots should be the same")
+	assert.Equal(t, root, MerkleRootFromProof(leaves[2], 2, []common.Hash{leaves[3], left}), "Roots should be the same")
+	assert.Equal(t, root, MerkleRootFromProof(leaves[3], 3, []common.Hash{leaves[2], left}), "Roots should be the same")
+	assert.NotEqual(t, root, MerkleRootFromProof(leaves[3], 2, []common.Hash{leaves[2], left}), "Roots should differ for wrong index")
+}
+
+func TestVerifyL2ToL1LogProof(t *testing.T) {
+	txIndex := hexutil.Uint(3)
+	log := &types.L2ToL1Log{
+		TxIndexInL1Batch: &txIndex,
+		IsService:        true,
+		Sender:           L1MessengerAddress,
+		Key:              common.HexToHash("0x01").Hex(),
+		Value:            common.HexToHash("0x02").Hex(),
+	}
+	leaf, err := L2ToL1LogLeaf(log)
+	assert.NoError(t, err, "L2ToL1LogLeaf should not return error")
+	sibling := common.HexToHash("0x05")
+	proof := &types.MessageProof{
+		Id:    1,
+		Proof: []common.Hash{sibling},
+		Root:  crypto.Keccak256Hash(sibling.Bytes(), leaf.Bytes()),
+	}
+	assert.NoError(t, VerifyL2ToL1LogProof(log, proof), "VerifyL2ToL1LogProof should not return error")
+
+	log.Value = common.HexToHash("0x03").Hex()
+	assert.Error(t, VerifyL2ToL1LogProof(log, proof), "VerifyL2ToL1LogProof should return error for tampered log")
+}
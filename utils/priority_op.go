@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/zksync-sdk/zksync2-go/contracts/zksync"
+	"github.com/zksync-sdk/zksync2-go/types"
+)
+
+// L2HashFromPriorityOp returns the canonical hash of the L2 priority transaction and the transaction itself
+// from the receipt of the L1 transaction that submitted the priority operation to the main contract.
+// It does not communicate with the network, so it can be used before the L2 node processes the transaction.
+func L2HashFromPriorityOp(l1TxReceipt *ethTypes.Receipt, mainContract common.Address) (common.Hash, *types.PriorityTransaction, error) {
+	mainContractAbi, err := zksync.IZkSyncMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, nil, fmt.Errorf("failed to load IZkSync ABI: %w", err)
+	}
+	event, ok := mainContractAbi.Events["NewPriorityRequest"]
+	if !ok {
+		return common.Hash{}, nil, errors.New("NewPriorityRequest event not found in IZkSync ABI")
+	}
+	filterer, err := zksync.NewIZkSyncFilterer(mainContract, nil)
+	if err != nil {
+		return common.Hash{}, nil, fmt.Errorf("failed to load IZkSync: %w", err)
+	}
+
+	for _, l := range l1TxReceipt.Logs {
+		if l.Address != mainContract || len(l.Topics) == 0 || l.Topics[0] != event.ID {
+			continue
+		}
+		req, errParse := filterer.ParseNewPriorityRequest(*l)
+		if errParse != nil {
+			return common.Hash{}, nil, fmt.Errorf("failed to ParseNewPriorityRequest: %w", errParse)
+		}
+		// the main contract computes the hash as keccak256(abi.encode(transaction))
+		encoded, errEncode := abi.Arguments{event.Inputs[3]}.Pack(req.Transaction)
+		if errEncode != nil {
+			return common.Hash{}, nil, fmt.Errorf("failed to encode canonical transaction: %w", errEncode)
+		}
+		hash := common.Hash(req.TxHash)
+		if crypto.Keccak256Hash(encoded) != hash {
+			return common.Hash{}, nil, errors.New("canonical transaction hash mismatch")
+		}
+		return hash, newPriorityTransaction(hash, req), nil
+	}
+	return common.Hash{}, nil, errors.New("NewPriorityRequest event not found in receipt")
+}
+
+func newPriorityTransaction(hash common.Hash, req *zksync.IZkSyncNewPriorityRequest) *types.PriorityTransaction {
+	tx := req.Transaction
+	from := common.BigToAddress(tx.From)
+	factoryDepsHashes := make([]common.Hash, len(tx.FactoryDeps))
+	for i, dep := range tx.FactoryDeps {
+		factoryDepsHashes[i] = common.BigToHash(dep)
+	}
+	return &types.PriorityTransaction{
+		Hash:                   hash,
+		PriorityOpId:           req.TxId,
+		ExpirationTimestamp:    req.ExpirationTimestamp,
+		TxType:                 uint8(tx.TxType.Uint64()),
+		From:                   from,
+		To:                     common.BigToAddress(tx.To),
+		GasLimit:               tx.GasLimit,
+		GasPerPubdataByteLimit: tx.GasPerPubdataByteLimit,
+		MaxFeePerGas:           tx.MaxFeePerGas,
+		MaxPriorityFeePerGas:   tx.MaxPriorityFeePerGas,
+		Paymaster:              common.BigToAddress(tx.Paymaster),
+		Nonce:                  tx.Nonce,
+		Value:                  tx.Value,
+		ToMint:                 tx.Reserved[0],
+		RefundRecipient:        common.BigToAddress(tx.Reserved[1]),
+		Data:                   tx.Data,
+		FactoryDeps:            req.FactoryDeps,
+		FactoryDepsHashes:      factoryDepsHashes,
+	}
+}
+
+// IsAliasedSender reports whether the sender of the priority transaction is the L1 -> L2 alias of the L1 sender,
+// which is the address that called the main contract. The main contract aliases the sender if it is a contract,
+// such as the L1 bridge, while the sender that is an EOA is used as it is.
+func IsAliasedSender(tx *types.PriorityTransaction, l1Sender common.Address) bool {
+	return tx.From == ApplyL1ToL2Alias(l1Sender)
+}
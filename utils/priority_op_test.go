@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/zksync-sdk/zksync2-go/contracts/zksync"
+	"github.com/zksync-sdk/zksync2-go/types"
+	"math/big"
+	"testing"
+)
+
+func TestL2HashFromPriorityOp(t *testing.T) {
+	mainContract := common.HexToAddress("0x9A6DE0f62Aa270A8bCB1e2610078650D539B1Ef9")
+	l1Bridge := common.HexToAddress("0x702942B8205E5dEdCD3374E5f4419843adA76Eeb")
+	l2Bridge := common.HexToAddress("0x681A1AFdC2e06776816386500D2D461a6C96cB45")
+	refundRecipient := common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049")
+	factoryDep := []byte{0x01, 0x02}
+	canonicalTx := zksync.IMailboxL2CanonicalTransaction{
+		TxType:                 big.NewInt(types.PriorityTxType),
+		From:                   new(big.Int).SetBytes(ApplyL1ToL2Alias(l1Bridge).Bytes()),
+		To:                     new(big.Int).SetBytes(l2Bridge.Bytes()),
+		GasLimit:               big.NewInt(300_000),
+		GasPerPubdataByteLimit: big.NewInt(800),
+		MaxFeePerGas:           big.NewInt(250_000_000),
+		MaxPriorityFeePerGas:   new(big.Int),
+		Paymaster:              new(big.Int),
+		Nonce:                  big.NewInt(42),
+		Value:                  new(big.Int),
+		Reserved:               [4]*big.Int{big.NewInt(75_000_000_000_000), new(big.Int).SetBytes(refundRecipient.Bytes()), new(big.Int), new(big.Int)},
+		Data:                   []byte{0xcf, 0xe7, 0xaf, 0x7c},
+		Signature:              []byte{},
+		FactoryDeps:            []*big.Int{new(big.Int).SetBytes(crypto.Keccak256(factoryDep))},
+		PaymasterInput:         []byte{},
+		ReservedDynamic:        []byte{},
+	}
+
+	mainContractAbi, err := zksync.IZkSyncMetaData.GetAbi()
+	assert.NoError(t, err, "GetAbi should not return error")
+	event := mainContractAbi.Events["NewPriorityRequest"]
+	encoded, err := abi.Arguments{event.Inputs[3]}.Pack(canonicalTx)
+	assert.NoError(t, err, "Pack should not return error")
+	expectedHash := crypto.Keccak256Hash(encoded)
+	data, err := event.Inputs.Pack(big.NewInt(42), expectedHash, uint64(1700000000), canonicalTx, [][]byte{factoryDep})
+	assert.NoError(t, err, "Pack should not return error")
+	receipt := &ethTypes.Receipt{Logs: []*ethTypes.Log{
+		{Address: l1Bridge, Topics: []common.Hash{crypto.Keccak256Hash([]byte("DepositInitiated"))}},
+		{Address: mainContract, Topics: []common.Hash{event.ID}, Data: data},
+	}}
+
+	hash, tx, err := L2HashFromPriorityOp(receipt, mainContract)
+	assert.NoError(t, err, "L2HashFromPriorityOp should not return error")
+	assert.Equal(t, expectedHash, hash, "Hashes should be the same")
+	assert.Equal(t, expectedHash, tx.Hash, "Transaction hash should be the same")
+	assert.Equal(t, uint8(types.PriorityTxType), tx.TxType, "Transaction type should be priority")
+	assert.Equal(t, ApplyL1ToL2Alias(l1Bridge), tx.From, "Sender should be aliased")
+	assert.True(t, IsAliasedSender(tx, l1Bridge), "Sender should be alias of L1 bridge")
+	assert.Equal(t, l2Bridge, tx.To, "Recipient should be L2 bridge")
+	assert.Equal(t, refundRecipient, tx.RefundRecipient, "Refund recipients should be the same")
+	assert.Equal(t, big.NewInt(75_000_000_000_000), tx.ToMint, "Minted values should be the same")
+	assert.Equal(t, big.NewInt(300_000), tx.GasLimit, "Gas limits should be the same")
+	assert.Equal(t, uint64(1700000000), tx.ExpirationTimestamp, "Expiration timestamps should be the same")
+	assert.Equal(t, [][]byte{factoryDep}, tx.FactoryDeps, "Factory deps should be the same")
+	assert.Equal(t, crypto.Keccak256Hash(factoryDep), tx.FactoryDepsHashes[0], "Factory dep hashes should be the same")
+
+	_, _, err = L2HashFromPriorityOp(receipt, l1Bridge)
+	assert.Error(t, err, "L2HashFromPriorityOp should return error if event is not emitted by main contract")
+
+	receipt.Logs[1].Data, err = event.Inputs.Pack(big.NewInt(42), common.Hash{}, uint64(1700000000), canonicalTx, [][]byte{factoryDep})
+	assert.NoError(t, err, "Pack should not return error")
+	_, _, err = L2HashFromPriorityOp(receipt, mainContract)
+	assert.Error(t, err, "L2HashFromPriorityOp should return error if hash does not match transaction")
+}
+
+func TestIsAliasedSenderForEOA(t *testing.T) {
+	sender := common.HexToAddress("0x36615Cf349d7F6344891B1e7CA7C72883F5dc049")
+	tx := &types.PriorityTransaction{From: sender}
+
+	assert.False(t, IsAliasedSender(tx, sender), "Sender should not be aliased for EOA")
+	tx.From = ApplyL1ToL2Alias(sender)
+	assert.True(t, IsAliasedSender(tx, sender), "Sender should be aliased for contract")
+}
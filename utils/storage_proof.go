@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/zksync-sdk/zksync2-go/types"
+	"golang.org/x/crypto/blake2s"
+)
+
+// storageTreeDepth is the depth of the sparse Merkle tree of the batch state.
+const storageTreeDepth = 256
+
+// emptyStorageSubtreeHashes contains the hashes of the empty subtrees of the tree of the batch state,
+// indexed by their depth counted from the leaves.
+var emptyStorageSubtreeHashes = func() []common.Hash {
+	hashes := make([]common.Hash, storageTreeDepth)
+	hashes[0] = blake2s.Sum256(make([]byte, 8+common.HashLength))
+	for i := 1; i < storageTreeDepth; i++ {
+		hashes[i] = storageBranchHash(hashes[i-1], hashes[i-1])
+	}
+	return hashes
+}()
+
+// StorageTreeKey returns the key of the storage slot in the sparse Merkle tree of the batch state, which is
+// computed as blake2s256(address left-padded to 32 bytes ++ storage key).
+func StorageTreeKey(address common.Address, key common.Hash) common.Hash {
+	return blake2s.Sum256(append(common.BytesToHash(address.Bytes()).Bytes(), key.Bytes()...))
+}
+
+// StorageLeafHash returns the hash of the leaf of the sparse Merkle tree of the batch state, which is computed
+// as blake2s256(leaf index as 8-byte big-endian ++ value).
+func StorageLeafHash(index uint64, value common.Hash) common.Hash {
+	leaf := make([]byte, 8, 8+common.HashLength)
+	binary.BigEndian.PutUint64(leaf, index)
+	return blake2s.Sum256(append(leaf, value.Bytes()...))
+}
+
+// StorageProofRoot returns the root of the sparse Merkle tree of the batch state which contains the storage
+// value proved by the proof. The path of the proof is ordered from the root down, with the trailing empty
+// subtrees omitted, which are restored before the path is folded from the leaf up to the root.
+func StorageProofRoot(address common.Address, proof *types.StorageKeyProof) (common.Hash, error) {
+	if len(proof.Proof) > storageTreeDepth {
+		return common.Hash{}, fmt.Errorf("proof of key %s is longer than the tree depth", proof.Key)
+	}
+	key := StorageTreeKey(address, proof.Key)
+	hash := StorageLeafHash(proof.Index, proof.Value)
+	emptyCount := storageTreeDepth - len(proof.Proof)
+	for depth := 0; depth < storageTreeDepth; depth++ {
+		sibling := emptyStorageSubtreeHashes[depth]
+		if depth >= emptyCount {
+			sibling = proof.Proof[len(proof.Proof)-1-(depth-emptyCount)]
+		}
+		// The bits of the key select the path from the leaf up, starting with the least significant bit.
+		if key[common.HashLength-1-depth/8]>>(depth%8)&1 == 1 {
+			hash = storageBranchHash(sibling, hash)
+		} else {
+			hash = storageBranchHash(hash, sibling)
+		}
+	}
+	return hash, nil
+}
+
+// VerifyStorageProof checks that the proof is given for the requested address and keys, in the order of
+// the keys, and that each storage proof leads to the root hash of the batch state, which is returned by
+// the L1BatchDetails method of the client.
+func VerifyStorageProof(proof *types.StorageProof, address common.Address, keys []common.Hash, rootHash common.Hash) error {
+	if proof == nil {
+		return errors.New("storage proof is not provided")
+	}
+	if proof.Address != address {
+		return fmt.Errorf("proof is given for address %s instead of %s", proof.Address, address)
+	}
+	if len(proof.Proofs) != len(keys) {
+		return fmt.Errorf("proof contains %d keys instead of %d", len(proof.Proofs), len(keys))
+	}
+	for i := range proof.Proofs {
+		if proof.Proofs[i].Key != keys[i] {
+			return fmt.Errorf("proof is given for key %s instead of %s", proof.Proofs[i].Key, keys[i])
+		}
+		root, err := StorageProofRoot(address, &proof.Proofs[i])
+		if err != nil {
+			return err
+		}
+		if root != rootHash {
+			return fmt.Errorf("proof of key %s leads to root %s instead of %s", proof.Proofs[i].Key, root, rootHash)
+		}
+	}
+	return nil
+}
+
+func storageBranchHash(left, right common.Hash) common.Hash {
+	return blake2s.Sum256(append(left.Bytes(), right.Bytes()...))
+}
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/zksync-sdk/zksync2-go/types"
+	"golang.org/x/crypto/blake2s"
+	"math/big"
+	"testing"
+)
+
+// foldStorageProof folds the full leaf-to-root path of the sparse Merkle tree of the batch state.
+func foldStorageProof(key common.Hash, leaf common.Hash, path []common.Hash) common.Hash {
+	bits := new(big.Int).SetBytes(key.Bytes())
+	hash := leaf
+	for depth, sibling := range path {
+		if bits.Bit(depth) == 1 {
+			hash = blake2s.Sum256(append(sibling.Bytes(), hash.Bytes()...))
+		} else {
+			hash = blake2s.Sum256(append(hash.Bytes(), sibling.Bytes()...))
+		}
+	}
+	return hash
+}
+
+func TestStorageProofRoot(t *testing.T) {
+	address := NonceHolderAddress
+	proof := &types.StorageKeyProof{
+		Key:   common.HexToHash("0x01"),
+		Value: common.HexToHash("0x2a"),
+		Index: 5,
+	}
+	leaf := StorageLeafHash(proof.Index, proof.Value)
+	assert.Equal(t, common.Hash(blake2s.Sum256(append(common.LeftPadBytes([]byte{5}, 8), proof.Value.Bytes()...))), leaf,
+		"Leaf hashes should be the same")
+	key := StorageTreeKey(address, proof.Key)
+	assert.Equal(t, common.Hash(blake2s.Sum256(append(common.LeftPadBytes(address.Bytes(), 32), proof.Key.Bytes()...))), key,
+		"Tree keys should be the same")
+
+	empty := make([]common.Hash, 256)
+	empty[0] = blake2s.Sum256(make([]byte, 40))
+	for i := 1; i < 256; i++ {
+		empty[i] = blake2s.Sum256(append(empty[i-1].Bytes(), empty[i-1].Bytes()...))
+	}
+
+	root, err := StorageProofRoot(address, proof)
+	assert.NoError(t, err, "StorageProofRoot should not return error")
+	assert.Equal(t, foldStorageProof(key, leaf, empty), root, "Root of the tree with single leaf should be the same")
+
+	// The proof lists the siblings from the root down, omitting the trailing empty subtrees.
+	sibling := common.HexToHash("0x0b")
+	proof.Proof = []common.Hash{sibling}
+	path := append(append([]common.Hash{}, empty[:255]...), sibling)
+	root, err = StorageProofRoot(address, proof)
+	assert.NoError(t, err, "StorageProofRoot should not return error")
+	assert.Equal(t, foldStorageProof(key, leaf, path), root, "Roots should be the same")
+
+	keys := []common.Hash{proof.Key}
+	storageProof := &types.StorageProof{Address: address, Proofs: []types.StorageKeyProof{*proof}}
+	assert.NoError(t, VerifyStorageProof(storageProof, address, keys, root), "VerifyStorageProof should not return error")
+	assert.Error(t, VerifyStorageProof(storageProof, L2EthTokenAddress, keys, root),
+		"VerifyStorageProof should return error for proof of other address")
+	assert.Error(t, VerifyStorageProof(storageProof, address, []common.Hash{common.HexToHash("0x02")}, root),
+		"VerifyStorageProof should return error for proof of other key")
+	assert.Error(t, VerifyStorageProof(&types.StorageProof{Address: address}, address, keys, root),
+		"VerifyStorageProof should return error for empty proof")
+	assert.Error(t, VerifyStorageProof(storageProof, address, append(keys, common.HexToHash("0x02")), root),
+		"VerifyStorageProof should return error for missing key")
+	proof.Value = common.HexToHash("0x2b")
+	assert.Error(t, VerifyStorageProof(&types.StorageProof{
+		Address: address,
+		Proofs:  []types.StorageKeyProof{*proof},
+	}, address, keys, root), "VerifyStorageProof should return error for tampered value")
+
+	proof.Proof = make([]common.Hash, 257)
+	_, err = StorageProofRoot(address, proof)
+	assert.Error(t, err, "StorageProofRoot should return error for too long proof")
+}
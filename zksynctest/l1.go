@@ -0,0 +1,266 @@
+package zksynctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/zksync-sdk/zksync2-go/contracts/zksync"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+	"time"
+)
+
+// l1State is the state of L1 network, which only tracks the transactions sent to it and the withdrawals
+// finalized by the main contract.
+type l1State struct {
+	balances    map[common.Address]*big.Int
+	nonces      map[common.Address]uint64
+	txs         []*types.Transaction
+	receipts    map[common.Hash]*types.Receipt
+	finalized   map[withdrawalKey]bool
+	operatorTxs uint64
+}
+
+// withdrawalKey identifies the withdrawal by the batch number and the index of its L2 -> L1 log in the batch.
+type withdrawalKey struct {
+	batch uint64
+	index uint64
+}
+
+func (s *l1State) balance(account common.Address) *big.Int {
+	if balance, ok := s.balances[account]; ok {
+		return new(big.Int).Set(balance)
+	}
+	return big.NewInt(0)
+}
+
+// header returns the header of the latest block, where each transaction is included in its own block.
+func (s *l1State) header(gasPrice *big.Int) *types.Header {
+	return &types.Header{
+		UncleHash:   types.EmptyUncleHash,
+		Root:        types.EmptyRootHash,
+		TxHash:      types.EmptyTxsHash,
+		ReceiptHash: types.EmptyReceiptsHash,
+		Difficulty:  big.NewInt(0),
+		Number:      big.NewInt(int64(len(s.txs))),
+		GasLimit:    30_000_000,
+		Time:        uint64(time.Now().Unix()),
+		BaseFee:     new(big.Int).Set(gasPrice),
+	}
+}
+
+// l1EthAPI serves the eth_ namespace of L1 network.
+type l1EthAPI struct {
+	n *Node
+}
+
+func (api *l1EthAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(api.n.config.L1ChainID))
+}
+
+func (api *l1EthAPI) BlockNumber() hexutil.Uint64 {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	return hexutil.Uint64(len(api.n.l1.txs))
+}
+
+func (api *l1EthAPI) GetBlockByNumber(_ string, _ bool) (map[string]interface{}, error) {
+	api.n.mu.Lock()
+	raw, err := json.Marshal(api.n.l1.header(api.n.config.GasPrice))
+	api.n.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err = json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	fields["transactions"] = []interface{}{}
+	fields["uncles"] = []common.Hash{}
+	return fields, nil
+}
+
+func (api *l1EthAPI) GasPrice() *hexutil.Big {
+	return (*hexutil.Big)(api.n.config.GasPrice)
+}
+
+func (api *l1EthAPI) MaxPriorityFeePerGas() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(0))
+}
+
+func (api *l1EthAPI) GetBalance(account common.Address, _ *json.RawMessage) *hexutil.Big {
+	return (*hexutil.Big)(api.n.L1Balance(account))
+}
+
+func (api *l1EthAPI) GetTransactionCount(account common.Address, _ *json.RawMessage) hexutil.Uint64 {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	return hexutil.Uint64(api.n.l1.nonces[account])
+}
+
+func (api *l1EthAPI) GetCode(account common.Address, _ *json.RawMessage) hexutil.Bytes {
+	if account == MainContractAddress || account == L1Erc20DefaultBridgeAddress {
+		return contractCode
+	}
+	return hexutil.Bytes{}
+}
+
+func (api *l1EthAPI) EstimateGas(args callArgs, _ *json.RawMessage) (hexutil.Uint64, error) {
+	if args.To != nil && *args.To == MainContractAddress {
+		api.n.mu.Lock()
+		defer api.n.mu.Unlock()
+		if _, err := api.n.callMainContract(args.data(), false); err != nil {
+			return 0, err
+		}
+	}
+	return 500_000, nil
+}
+
+func (api *l1EthAPI) Call(args callArgs, _ *json.RawMessage) (hexutil.Bytes, error) {
+	if args.To == nil || *args.To != MainContractAddress {
+		return hexutil.Bytes{}, nil
+	}
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	return api.n.callMainContract(args.data(), false)
+}
+
+func (api *l1EthAPI) SendRawTransaction(input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(big.NewInt(api.n.config.L1ChainID)), tx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to recover signer: %w", err)
+	}
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	if nonce := api.n.l1.nonces[from]; tx.Nonce() != nonce {
+		return common.Hash{}, fmt.Errorf("invalid nonce: next nonce %d, tx nonce %d", nonce, tx.Nonce())
+	}
+	if tx.To() != nil && *tx.To() == MainContractAddress {
+		if _, err = api.n.callMainContract(tx.Data(), true); err != nil {
+			return common.Hash{}, err
+		}
+	}
+	api.n.l1.nonces[from]++
+	api.n.l1.txs = append(api.n.l1.txs, tx)
+	header := api.n.l1.header(api.n.config.GasPrice)
+	api.n.l1.receipts[tx.Hash()] = &types.Receipt{
+		Type:              tx.Type(),
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: tx.Gas(),
+		Logs:              []*types.Log{},
+		TxHash:            tx.Hash(),
+		GasUsed:           tx.Gas(),
+		EffectiveGasPrice: api.n.config.GasPrice,
+		BlockHash:         header.Hash(),
+		BlockNumber:       header.Number,
+	}
+	return tx.Hash(), nil
+}
+
+func (api *l1EthAPI) GetTransactionReceipt(hash common.Hash) *types.Receipt {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	return api.n.l1.receipts[hash]
+}
+
+// callMainContract executes the call of the main contract on L1 network. The state is changed only if
+// the call is sent in the transaction.
+func (n *Node) callMainContract(data []byte, transact bool) (hexutil.Bytes, error) {
+	method, args, err := unpackCall(zkSyncAbi, data)
+	if err != nil {
+		return nil, errExecutionReverted
+	}
+	switch method.Name {
+	case "isEthWithdrawalFinalized":
+		key := withdrawalKey{args[0].(*big.Int).Uint64(), args[1].(*big.Int).Uint64()}
+		return method.Outputs.Pack(n.l1.finalized[key])
+	case "l2LogsRootHash":
+		var root common.Hash
+		if b, errBatch := n.executedBatch(args[0].(*big.Int)); errBatch == nil {
+			root = b.root
+		}
+		return method.Outputs.Pack(root)
+	case "proveL2MessageInclusion":
+		message := *abi.ConvertType(args[2], new(zksync.L2Message)).(*zksync.L2Message)
+		err = n.proveL2MessageInclusion(args[0].(*big.Int), args[1].(*big.Int), message, args[3].([][32]byte))
+		return method.Outputs.Pack(err == nil)
+	case "finalizeEthWithdrawal":
+		batchNumber, index := args[0].(*big.Int), args[1].(*big.Int)
+		message := zksync.L2Message{
+			TxNumberInBatch: args[2].(uint16),
+			Sender:          utils.L2EthTokenAddress,
+			Data:            args[3].([]byte),
+		}
+		key := withdrawalKey{batchNumber.Uint64(), index.Uint64()}
+		if n.l1.finalized[key] {
+			return nil, fmt.Errorf("%w: withdrawal is already finalized", errExecutionReverted)
+		}
+		selector := zkSyncAbi.Methods["finalizeEthWithdrawal"].ID
+		if len(message.Data) != 56 || !bytes.Equal(message.Data[:4], selector) {
+			return nil, fmt.Errorf("%w: incorrect ETH message", errExecutionReverted)
+		}
+		if err = n.proveL2MessageInclusion(batchNumber, index, message, args[4].([][32]byte)); err != nil {
+			return nil, fmt.Errorf("%w: %v", errExecutionReverted, err)
+		}
+		if transact {
+			n.l1.finalized[key] = true
+			receiver := common.BytesToAddress(message.Data[4:24])
+			n.l1.balances[receiver] = new(big.Int).Add(n.l1.balance(receiver), new(big.Int).SetBytes(message.Data[24:]))
+		}
+		return hexutil.Bytes{}, nil
+	default:
+		return nil, errExecutionReverted
+	}
+}
+
+// proveL2MessageInclusion checks that the message is included in the executed batch, at the given index
+// of the L2 -> L1 logs of the batch.
+func (n *Node) proveL2MessageInclusion(batchNumber, index *big.Int, message zksync.L2Message, proof [][32]byte) error {
+	b, err := n.executedBatch(batchNumber)
+	if err != nil {
+		return err
+	}
+	txIndex := hexutil.Uint(message.TxNumberInBatch)
+	shardId := hexutil.Uint(0)
+	leaf, err := utils.L2ToL1LogLeaf(&zkTypes.L2ToL1Log{
+		ShardId:          &shardId,
+		IsService:        true,
+		TxIndexInL1Batch: &txIndex,
+		Sender:           utils.L1MessengerAddress,
+		Key:              common.BytesToHash(message.Sender.Bytes()).String(),
+		Value:            crypto.Keccak256Hash(message.Data).String(),
+	})
+	if err != nil {
+		return err
+	}
+	proofHashes := make([]common.Hash, len(proof))
+	for i, p := range proof {
+		proofHashes[i] = p
+	}
+	if utils.MerkleRootFromProof(leaf, int(index.Int64()), proofHashes) != b.root {
+		return errors.New("invalid proof")
+	}
+	return nil
+}
+
+func (n *Node) executedBatch(number *big.Int) (*batch, error) {
+	if !number.IsUint64() || number.Uint64() >= uint64(len(n.batches)) {
+		return nil, fmt.Errorf("batch %s does not exist", number)
+	}
+	b := n.batches[number.Uint64()]
+	if b.executed == (common.Hash{}) {
+		return nil, fmt.Errorf("batch %s is not executed", number)
+	}
+	return b, nil
+}
@@ -0,0 +1,677 @@
+package zksynctest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+	"time"
+)
+
+// callArgs are the arguments of eth_call and eth_estimateGas.
+type callArgs struct {
+	From  common.Address  `json:"from"`
+	To    *common.Address `json:"to"`
+	Value *hexutil.Big    `json:"value"`
+	Data  hexutil.Bytes   `json:"data"`
+	Input hexutil.Bytes   `json:"input"`
+}
+
+func (a *callArgs) data() []byte {
+	if len(a.Input) > 0 {
+		return a.Input
+	}
+	return a.Data
+}
+
+func (a *callArgs) value() *big.Int {
+	if a.Value == nil {
+		return big.NewInt(0)
+	}
+	return a.Value.ToInt()
+}
+
+// filterArgs are the arguments of eth_getLogs.
+type filterArgs struct {
+	FromBlock *string          `json:"fromBlock"`
+	ToBlock   *string          `json:"toBlock"`
+	BlockHash *common.Hash     `json:"blockHash"`
+	Addresses []common.Address `json:"address"`
+	Topics    [][]common.Hash  `json:"topics"`
+}
+
+// l2EthAPI serves the eth_ namespace of L2 network.
+type l2EthAPI struct {
+	n *Node
+}
+
+func (api *l2EthAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(api.n.config.ChainID))
+}
+
+func (api *l2EthAPI) BlockNumber() hexutil.Uint64 {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	return hexutil.Uint64(api.n.latestBlock().header.Number.Uint64())
+}
+
+func (api *l2EthAPI) GasPrice() *hexutil.Big {
+	return (*hexutil.Big)(api.n.config.GasPrice)
+}
+
+func (api *l2EthAPI) MaxPriorityFeePerGas() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(0))
+}
+
+func (api *l2EthAPI) GetBalance(account common.Address, _ *json.RawMessage) *hexutil.Big {
+	return (*hexutil.Big)(api.n.Balance(account))
+}
+
+func (api *l2EthAPI) GetTransactionCount(account common.Address, _ *json.RawMessage) hexutil.Uint64 {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	return hexutil.Uint64(api.n.nonces[account])
+}
+
+func (api *l2EthAPI) GetCode(account common.Address, _ *json.RawMessage) hexutil.Bytes {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	if api.n.isContract(account) {
+		return contractCode
+	}
+	return hexutil.Bytes{}
+}
+
+func (api *l2EthAPI) EstimateGas(args callArgs, _ *json.RawMessage) (hexutil.Uint64, error) {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	if _, err := api.n.execute(args.From, args.To, args.value(), args.data()); err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(api.n.config.GasUsed), nil
+}
+
+func (api *l2EthAPI) Call(args callArgs, _ *json.RawMessage) (hexutil.Bytes, error) {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	if args.To == nil || !api.n.isContract(*args.To) {
+		return hexutil.Bytes{}, nil
+	}
+	switch {
+	case *args.To == utils.L2EthTokenAddress:
+		method, params, err := unpackCall(ethTokenAbi, args.data())
+		if err != nil || method.Name != "balanceOf" {
+			return nil, errExecutionReverted
+		}
+		account := common.BigToAddress(params[0].(*big.Int))
+		return method.Outputs.Pack(api.n.balance(account))
+	case api.n.tokens[*args.To] != nil:
+		method, params, err := unpackCall(erc20Abi, args.data())
+		if err != nil {
+			return nil, errExecutionReverted
+		}
+		switch method.Name {
+		case "balanceOf":
+			return method.Outputs.Pack(api.n.tokenBalance(*args.To, params[0].(common.Address)))
+		case "decimals":
+			return method.Outputs.Pack(uint8(18))
+		}
+	}
+	return nil, errExecutionReverted
+}
+
+func (api *l2EthAPI) SendRawTransaction(input hexutil.Bytes) (common.Hash, error) {
+	tx, err := decodeTransaction(input, big.NewInt(api.n.config.ChainID))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	if err = api.n.sendTransaction(tx); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.hash, nil
+}
+
+func (api *l2EthAPI) GetTransactionByHash(hash common.Hash) *zkTypes.TransactionResponse {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	if tx, ok := api.n.txs[hash]; ok {
+		return api.n.transactionResponse(tx)
+	}
+	return nil
+}
+
+func (api *l2EthAPI) GetTransactionReceipt(hash common.Hash) *zkTypes.Receipt {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	if tx, ok := api.n.txs[hash]; ok {
+		return api.n.receipt(tx)
+	}
+	return nil
+}
+
+func (api *l2EthAPI) GetBlockByNumber(number string, full bool) (map[string]interface{}, error) {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	blk, err := api.n.blockByNumber(number)
+	if err != nil || blk == nil {
+		return nil, err
+	}
+	return api.n.marshalBlock(blk, full)
+}
+
+func (api *l2EthAPI) GetBlockByHash(hash common.Hash, full bool) (map[string]interface{}, error) {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	for _, blk := range api.n.blocks {
+		if blk.header.Hash() == hash {
+			return api.n.marshalBlock(blk, full)
+		}
+	}
+	return nil, nil
+}
+
+func (api *l2EthAPI) GetLogs(args filterArgs) ([]*zkTypes.Log, error) {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	from, to := uint64(0), api.n.latestBlock().header.Number.Uint64()
+	if args.FromBlock != nil {
+		blk, err := api.n.blockByNumber(*args.FromBlock)
+		if err != nil {
+			return nil, err
+		}
+		if blk == nil {
+			return []*zkTypes.Log{}, nil
+		}
+		from = blk.header.Number.Uint64()
+	}
+	if args.ToBlock != nil {
+		blk, err := api.n.blockByNumber(*args.ToBlock)
+		if err != nil {
+			return nil, err
+		}
+		if blk != nil {
+			to = blk.header.Number.Uint64()
+		}
+	}
+	logs := make([]*zkTypes.Log, 0)
+	if from > to {
+		return logs, nil
+	}
+	for _, blk := range api.n.blocks[from : to+1] {
+		if blk.tx == nil || (args.BlockHash != nil && blk.header.Hash() != *args.BlockHash) {
+			continue
+		}
+		for _, l := range api.n.logs(blk.tx) {
+			if matchLog(&l.Log, args.Addresses, args.Topics) {
+				logs = append(logs, l)
+			}
+		}
+	}
+	return logs, nil
+}
+
+// l2ZksAPI serves the zks_ namespace of L2 network.
+type l2ZksAPI struct {
+	n *Node
+}
+
+func (api *l2ZksAPI) GetMainContract() common.Address {
+	return MainContractAddress
+}
+
+func (api *l2ZksAPI) GetBridgeContracts() *zkTypes.BridgeContracts {
+	return &zkTypes.BridgeContracts{
+		L1Erc20DefaultBridge: L1Erc20DefaultBridgeAddress,
+		L2Erc20DefaultBridge: L2Erc20DefaultBridgeAddress,
+	}
+}
+
+func (api *l2ZksAPI) GetTestnetPaymaster() common.Address {
+	return TestnetPaymasterAddress
+}
+
+func (api *l2ZksAPI) GetBaseTokenL1Address() common.Address {
+	return utils.EthAddressInContracts
+}
+
+// L1ChainId serves zks_L1ChainId.
+func (api *l2ZksAPI) L1ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(api.n.config.L1ChainID))
+}
+
+// L1BatchNumber serves zks_L1BatchNumber, returning the number of the latest sealed batch.
+func (api *l2ZksAPI) L1BatchNumber() *hexutil.Big {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	return (*hexutil.Big)(new(big.Int).SetUint64(api.n.sealedBatchNumber()))
+}
+
+func (api *l2ZksAPI) GetL1BatchBlockRange(number uint64) []hexutil.Uint64 {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	if number >= uint64(len(api.n.batches)) || len(api.n.batches[number].blocks) == 0 {
+		return nil
+	}
+	blocks := api.n.batches[number].blocks
+	return []hexutil.Uint64{
+		hexutil.Uint64(blocks[0].header.Number.Uint64()),
+		hexutil.Uint64(blocks[len(blocks)-1].header.Number.Uint64()),
+	}
+}
+
+func (api *l2ZksAPI) GetL1BatchDetails(number uint64) *zkTypes.BatchDetails {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	if number >= uint64(len(api.n.batches)) || api.n.batches[number].status == "" {
+		return nil
+	}
+	b := api.n.batches[number]
+	root := b.root
+	l2TxCount := uint(len(b.txs))
+	return &zkTypes.BatchDetails{
+		CommitTxHash:   hashOrNil(b.committed),
+		ProveTxHash:    hashOrNil(b.proven),
+		ExecuteTxHash:  hashOrNil(b.executed),
+		L1GasPrice:     api.n.config.GasPrice.Uint64(),
+		L2FairGasPrice: uint(api.n.config.GasPrice.Uint64()),
+		L2TxCount:      l2TxCount,
+		Number:         uint(b.number),
+		RootHash:       &root,
+		Status:         b.status,
+		Timestamp:      uint(b.timestamp),
+	}
+}
+
+func (api *l2ZksAPI) GetBlockDetails(number uint64) *zkTypes.BlockDetails {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	if number >= uint64(len(api.n.blocks)) {
+		return nil
+	}
+	blk := api.n.blocks[number]
+	details := &zkTypes.BlockDetails{
+		Number:         uint(number),
+		L1BatchNumber:  uint(blk.batch.number),
+		Timestamp:      uint(blk.header.Time),
+		RootHash:       blk.header.Hash(),
+		Status:         BatchStatusSealed,
+		CommitTxHash:   hashOrNil(blk.batch.committed),
+		ProveTxHash:    hashOrNil(blk.batch.proven),
+		ExecuteTxHash:  hashOrNil(blk.batch.executed),
+		L1GasPrice:     api.n.config.GasPrice,
+		L2FairGasPrice: api.n.config.GasPrice,
+	}
+	if blk.tx != nil {
+		details.L2TxCount = 1
+	}
+	if blk.batch.status == BatchStatusVerified {
+		details.Status = BatchStatusVerified
+	}
+	return details
+}
+
+func (api *l2ZksAPI) GetTransactionDetails(hash common.Hash) *zkTypes.TransactionDetails {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	tx, ok := api.n.txs[hash]
+	if !ok {
+		return nil
+	}
+	b := tx.block.batch
+	details := &zkTypes.TransactionDetails{
+		EthCommitTxHash:  hashOrNil(b.committed),
+		EthProveTxHash:   hashOrNil(b.proven),
+		EthExecuteTxHash: hashOrNil(b.executed),
+		Fee:              hexutil.Big(*tx.fee),
+		GasPerPubdata:    hexutil.Big(*utils.DefaultGasPerPubdataLimit),
+		InitiatorAddress: tx.from,
+		ReceivedAt:       tx.receivedAt,
+		Status:           "included",
+	}
+	switch {
+	case tx.status == types.ReceiptStatusFailed:
+		details.Status = "failed"
+	case b.status == BatchStatusVerified:
+		details.Status = "verified"
+	}
+	return details
+}
+
+// GetL2ToL1LogProof returns the proof of the L2 -> L1 log of the transaction, whose index is the position
+// of the log among the L2 -> L1 logs of the transaction. The proof is available once the batch is sealed.
+func (api *l2ZksAPI) GetL2ToL1LogProof(hash common.Hash, index *int) *zkTypes.MessageProof {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	tx, ok := api.n.txs[hash]
+	if !ok || tx.block.batch.status == "" {
+		return nil
+	}
+	i := 0
+	if index != nil {
+		i = *index
+	}
+	if i < 0 || i >= len(tx.l2ToL1Logs) {
+		return nil
+	}
+	return api.n.messageProof(tx.l2ToL1Logs[i])
+}
+
+func (api *l2ZksAPI) GetL2ToL1MsgProof(number uint32, sender common.Address, msg common.Hash) *zkTypes.MessageProof {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	if uint64(number) >= uint64(len(api.n.blocks)) {
+		return nil
+	}
+	blk := api.n.blocks[number]
+	if blk.tx == nil || blk.batch.status == "" {
+		return nil
+	}
+	for _, l := range blk.tx.l2ToL1Logs {
+		if l.sender == sender && common.HexToHash(l.l2ToL1Log().Value) == msg {
+			return api.n.messageProof(l)
+		}
+	}
+	return nil
+}
+
+func (api *l2ZksAPI) GetAllAccountBalances(account common.Address) map[common.Address]*hexutil.Big {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	balances := map[common.Address]*hexutil.Big{utils.EthAddress: (*hexutil.Big)(api.n.balance(account))}
+	for token, holders := range api.n.tokens {
+		if balance, ok := holders[account]; ok && balance.Sign() > 0 {
+			balances[token] = (*hexutil.Big)(new(big.Int).Set(balance))
+		}
+	}
+	return balances
+}
+
+func (api *l2ZksAPI) EstimateFee(args callArgs) (*zkTypes.Fee, error) {
+	api.n.mu.Lock()
+	defer api.n.mu.Unlock()
+	if _, err := api.n.execute(args.From, args.To, args.value(), args.data()); err != nil {
+		return nil, err
+	}
+	return &zkTypes.Fee{
+		GasLimit:             (*hexutil.Big)(new(big.Int).SetUint64(api.n.config.GasUsed)),
+		GasPerPubdataLimit:   (*hexutil.Big)(utils.DefaultGasPerPubdataLimit),
+		MaxFeePerGas:         (*hexutil.Big)(api.n.config.GasPrice),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(0)),
+	}, nil
+}
+
+// eip712TxType is the type of the EIP-712 transactions.
+const eip712TxType = 0x71
+
+// contractCode is the code returned for the contracts served by the node, which only needs to be non-empty.
+var contractCode = hexutil.Bytes{0x00}
+
+// decodeTransaction decodes the raw signed transaction, either EIP-712 or Ethereum one, and recovers its sender.
+// The EIP-712 transactions with the custom signature are accepted from the account they claim to be sent from.
+func decodeTransaction(raw []byte, chainID *big.Int) (*transaction, error) {
+	if len(raw) > 0 && raw[0] == eip712TxType {
+		tx712, signature, err := zkTypes.DecodeTransaction712(raw)
+		if err != nil {
+			return nil, err
+		}
+		if tx712.ChainID == nil || tx712.ChainID.Cmp(chainID) != 0 {
+			return nil, errors.New("invalid chain ID")
+		}
+		from, err := tx712.RecoverSigner(signature)
+		if tx712.From != nil && (err != nil || from != *tx712.From) {
+			if len(tx712.Meta.CustomSignature) == 0 {
+				return nil, errors.New("invalid signature")
+			}
+			from, err = *tx712.From, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to recover signer: %w", err)
+		}
+		hash, err := tx712.Hash(signature)
+		if err != nil {
+			return nil, err
+		}
+		return &transaction{
+			hash:       hash,
+			txType:     eip712TxType,
+			from:       from,
+			to:         tx712.To,
+			nonce:      tx712.Nonce.Uint64(),
+			value:      bigOrZero(tx712.Value),
+			data:       tx712.Data,
+			gas:        bigOrZero(tx712.Gas).Uint64(),
+			gasFeeCap:  bigOrZero(tx712.GasFeeCap),
+			gasTipCap:  bigOrZero(tx712.GasTipCap),
+			receivedAt: time.Now(),
+		}, nil
+	}
+
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	if tx.ChainId().Sign() != 0 && tx.ChainId().Cmp(chainID) != 0 {
+		return nil, errors.New("invalid chain ID")
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover signer: %w", err)
+	}
+	return &transaction{
+		hash:       tx.Hash(),
+		txType:     tx.Type(),
+		from:       from,
+		to:         tx.To(),
+		nonce:      tx.Nonce(),
+		value:      tx.Value(),
+		data:       tx.Data(),
+		gas:        tx.Gas(),
+		gasFeeCap:  tx.GasFeeCap(),
+		gasTipCap:  tx.GasTipCap(),
+		receivedAt: time.Now(),
+	}, nil
+}
+
+// blockByNumber returns the block with the given number or tag, or nil if the block does not exist.
+func (n *Node) blockByNumber(number string) (*block, error) {
+	switch number {
+	case "latest", "pending", "committed", "safe":
+		return n.latestBlock(), nil
+	case "finalized":
+		return n.finalizedBlock(), nil
+	case "earliest":
+		return n.blocks[0], nil
+	}
+	num, err := hexutil.DecodeUint64(number)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block number %s: %w", number, err)
+	}
+	if num >= uint64(len(n.blocks)) {
+		return nil, nil
+	}
+	return n.blocks[num], nil
+}
+
+func (n *Node) marshalBlock(blk *block, full bool) (map[string]interface{}, error) {
+	raw, err := json.Marshal(blk.header)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err = json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	fields["uncles"] = []common.Hash{}
+	fields["size"] = hexutil.Uint64(0)
+	fields["totalDifficulty"] = (*hexutil.Big)(big.NewInt(0))
+	fields["sealFields"] = []interface{}{}
+	fields["l1BatchNumber"] = nil
+	fields["l1BatchTimestamp"] = nil
+	if blk.batch.status != "" {
+		fields["l1BatchNumber"] = (*hexutil.Big)(new(big.Int).SetUint64(blk.batch.number))
+		fields["l1BatchTimestamp"] = (*hexutil.Big)(new(big.Int).SetUint64(blk.batch.timestamp))
+	}
+	switch {
+	case blk.tx == nil:
+		fields["transactions"] = []interface{}{}
+	case full:
+		fields["transactions"] = []interface{}{n.transactionResponse(blk.tx)}
+	default:
+		fields["transactions"] = []interface{}{blk.tx.hash}
+	}
+	return fields, nil
+}
+
+func (n *Node) transactionResponse(tx *transaction) *zkTypes.TransactionResponse {
+	blockHash := tx.block.header.Hash()
+	resp := &zkTypes.TransactionResponse{
+		BlockHash:            &blockHash,
+		BlockNumber:          (*hexutil.Big)(tx.block.header.Number),
+		ChainID:              hexutil.Big(*big.NewInt(n.config.ChainID)),
+		From:                 tx.from,
+		Gas:                  hexutil.Uint64(tx.gas),
+		GasPrice:             hexutil.Big(*n.config.GasPrice),
+		Hash:                 tx.hash,
+		Data:                 tx.data,
+		L1BatchTxIndex:       hexutil.Big(*new(big.Int).SetUint64(uint64(tx.index))),
+		MaxFeePerGas:         hexutil.Big(*tx.gasFeeCap),
+		MaxPriorityFeePerGas: hexutil.Big(*tx.gasTipCap),
+		Nonce:                hexutil.Uint64(tx.nonce),
+		Type:                 hexutil.Uint64(tx.txType),
+		Value:                hexutil.Big(*tx.value),
+	}
+	if tx.to != nil {
+		resp.To = *tx.to
+	}
+	if tx.block.batch.status != "" {
+		resp.L1BatchNumber = hexutil.Big(*new(big.Int).SetUint64(tx.block.batch.number))
+	}
+	return resp
+}
+
+// receipt returns the receipt of the transaction. The number of the batch and the index of the transaction
+// in the batch are provided once the batch is sealed.
+func (n *Node) receipt(tx *transaction) *zkTypes.Receipt {
+	logs := n.logs(tx)
+	ethLogs := make([]*types.Log, len(logs))
+	for i, l := range logs {
+		ethLogs[i] = &l.Log
+	}
+	l2ToL1Logs := make([]*zkTypes.L2ToL1Log, len(tx.l2ToL1Logs))
+	for i, l := range tx.l2ToL1Logs {
+		l2ToL1Logs[i] = l.l2ToL1Log()
+		l2ToL1Logs[i].BlockNumber = (*hexutil.Big)(tx.block.header.Number)
+		l2ToL1Logs[i].BlockHash = tx.block.header.Hash()
+		l2ToL1Logs[i].TransactionIndex = new(hexutil.Uint)
+		logIndex := hexutil.Uint(i)
+		l2ToL1Logs[i].Index = &logIndex
+	}
+	receipt := &zkTypes.Receipt{
+		Receipt: types.Receipt{
+			Type:              tx.txType,
+			Status:            tx.status,
+			CumulativeGasUsed: n.config.GasUsed,
+			Bloom:             types.CreateBloom(types.Receipts{{Logs: ethLogs}}),
+			Logs:              ethLogs,
+			TxHash:            tx.hash,
+			GasUsed:           n.config.GasUsed,
+			EffectiveGasPrice: n.config.GasPrice,
+			BlockHash:         tx.block.header.Hash(),
+			BlockNumber:       tx.block.header.Number,
+		},
+		From:              tx.from,
+		EffectiveGasPrice: (*hexutil.Big)(n.config.GasPrice),
+		Logs:              logs,
+		L2ToL1Logs:        l2ToL1Logs,
+	}
+	if tx.to != nil {
+		receipt.To = *tx.to
+	}
+	if tx.block.batch.status != "" {
+		receipt.L1BatchNumber = (*hexutil.Big)(new(big.Int).SetUint64(tx.block.batch.number))
+		receipt.L1BatchTxIndex = (*hexutil.Big)(new(big.Int).SetUint64(uint64(tx.index)))
+		for _, l := range l2ToL1Logs {
+			l.L1BatchNumber = receipt.L1BatchNumber
+		}
+	}
+	return receipt
+}
+
+// logs returns the logs of the transaction, which include the number of the batch once it is sealed.
+func (n *Node) logs(tx *transaction) []*zkTypes.Log {
+	var l1BatchNumber *hexutil.Big
+	if tx.block.batch.status != "" {
+		l1BatchNumber = (*hexutil.Big)(new(big.Int).SetUint64(tx.block.batch.number))
+	}
+	logs := make([]*zkTypes.Log, len(tx.logs))
+	for i, l := range tx.logs {
+		logs[i] = &zkTypes.Log{
+			Log: types.Log{
+				Address:     l.Address,
+				Topics:      l.Topics,
+				Data:        l.Data,
+				BlockNumber: tx.block.header.Number.Uint64(),
+				TxHash:      tx.hash,
+				BlockHash:   tx.block.header.Hash(),
+				Index:       uint(i),
+			},
+			L1BatchNumber: l1BatchNumber,
+		}
+	}
+	return logs
+}
+
+// messageProof returns the proof of the L2 -> L1 log in the Merkle tree of the logs of its sealed batch.
+func (n *Node) messageProof(l *l2ToL1Log) *zkTypes.MessageProof {
+	root, proof := merkleProof(l.tx.block.batch.leaves(), l.index)
+	return &zkTypes.MessageProof{Id: l.index, Proof: proof, Root: root}
+}
+
+func matchLog(l *types.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, address := range addresses {
+			found = found || address == l.Address
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(topics) > len(l.Topics) {
+		return false
+	}
+	for i, alternatives := range topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range alternatives {
+			found = found || topic == l.Topics[i]
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func hashOrNil(hash common.Hash) *common.Hash {
+	if hash == (common.Hash{}) {
+		return nil
+	}
+	return &hash
+}
+
+func bigOrZero(b *big.Int) *big.Int {
+	if b == nil {
+		return big.NewInt(0)
+	}
+	return b
+}
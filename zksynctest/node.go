@@ -0,0 +1,350 @@
+// Package zksynctest provides an in-memory fake of the zkSync Era node and its L1 network, which allows
+// wallets and clients to be unit tested without running the local-setup.
+package zksynctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/zksync-sdk/zksync2-go/clients"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// Addresses of the contracts which are served by the node.
+var (
+	MainContractAddress         = common.HexToAddress("0x9cc2bb1a4b59cec0b1ff7e6b02e5ba7cf3ea3fa2")
+	L1Erc20DefaultBridgeAddress = common.HexToAddress("0x5ae6c6fa5f4e3a2b0c2c1e63cbbf8bb6b6a6ec5d")
+	L2Erc20DefaultBridgeAddress = common.HexToAddress("0x7c79d0a3ec3e81ad8b8ba5bf0f3a93b2c09d2b7e")
+	TestnetPaymasterAddress     = common.HexToAddress("0x4b5df730c2e6b28e17013a1485e5d9bc41efe021")
+)
+
+// Statuses of the batches reported by zks_getL1BatchDetails and zks_getBlockDetails.
+const (
+	BatchStatusSealed   = "sealed"   // The batch is not executed on L1 network yet.
+	BatchStatusVerified = "verified" // The batch is executed on L1 network.
+)
+
+// Config configures the Node. Zero values select the defaults described for each field.
+type Config struct {
+	ChainID   int64    // The chain ID of L2 network. Defaults to 270.
+	L1ChainID int64    // The chain ID of L1 network. Defaults to 9.
+	GasPrice  *big.Int // The gas price on both networks. Defaults to 0.1 gwei.
+	GasUsed   uint64   // The gas used by each L2 transaction. Defaults to 150000.
+}
+
+// Node is an in-memory fake of the zkSync Era node and its L1 network. It serves the eth_ and zks_ JSON-RPC
+// methods used by the clients, backed by a simple state model:
+//   - L2 transactions are executed as soon as they are sent, each in its own block. They can transfer
+//     the base token, transfer the tokens added with AddToken, withdraw the base token and send messages
+//     to L1 network. Each transaction pays the fee of GasUsed * GasPrice, even if it reverts.
+//   - Blocks belong to the open batch until SealBatch is called. The sealed batch is then moved through
+//     CommitBatch, ProveBatch and ExecuteBatch, after which its L2 -> L1 logs can be proven on L1 network.
+//   - L1 network serves the main contract, which finalizes the ETH withdrawals of executed batches and
+//     proves the inclusion of L2 -> L1 messages. L1 transactions do not pay fees.
+//
+// The clients are attached using Node.Client and Node.L1Client. Node is safe for concurrent use.
+type Node struct {
+	config   Config
+	l2Server *rpc.Server
+	l1Server *rpc.Server
+
+	mu       sync.Mutex
+	balances map[common.Address]*big.Int
+	tokens   map[common.Address]map[common.Address]*big.Int
+	nonces   map[common.Address]uint64
+	txs      map[common.Hash]*transaction
+	blocks   []*block
+	batches  []*batch
+	l1       l1State
+}
+
+// NewNode creates the node whose genesis block is included in the executed batch 0, and whose batch 1 is open.
+func NewNode(config Config) *Node {
+	if config.ChainID == 0 {
+		config.ChainID = 270
+	}
+	if config.L1ChainID == 0 {
+		config.L1ChainID = 9
+	}
+	if config.GasPrice == nil {
+		config.GasPrice = big.NewInt(100_000_000)
+	}
+	if config.GasUsed == 0 {
+		config.GasUsed = 150_000
+	}
+	n := &Node{
+		config:   config,
+		balances: make(map[common.Address]*big.Int),
+		tokens:   make(map[common.Address]map[common.Address]*big.Int),
+		nonces:   make(map[common.Address]uint64),
+		txs:      make(map[common.Hash]*transaction),
+		l1: l1State{
+			balances:  make(map[common.Address]*big.Int),
+			nonces:    make(map[common.Address]uint64),
+			receipts:  make(map[common.Hash]*types.Receipt),
+			finalized: make(map[withdrawalKey]bool),
+		},
+	}
+	genesis := &batch{number: 0, status: BatchStatusVerified, timestamp: uint64(time.Now().Unix())}
+	genesis.root = merkleRoot(nil)
+	genesis.committed = n.operatorTxHash()
+	genesis.proven = n.operatorTxHash()
+	genesis.executed = n.operatorTxHash()
+	n.batches = append(n.batches, genesis)
+	n.newBlock(nil)
+	n.batches = append(n.batches, &batch{number: 1})
+
+	n.l2Server = rpc.NewServer()
+	n.l1Server = rpc.NewServer()
+	for _, err := range []error{
+		n.l2Server.RegisterName("eth", &l2EthAPI{n}),
+		n.l2Server.RegisterName("zks", &l2ZksAPI{n}),
+		n.l2Server.RegisterName("net", &netAPI{config.ChainID}),
+		n.l1Server.RegisterName("eth", &l1EthAPI{n}),
+		n.l1Server.RegisterName("net", &netAPI{config.L1ChainID}),
+	} {
+		if err != nil {
+			panic(fmt.Sprintf("failed to register API: %v", err))
+		}
+	}
+	return n
+}
+
+// Client creates the client connected to L2 network of the node.
+func (n *Node) Client() clients.Client {
+	return clients.NewClient(dial(n.l2Server))
+}
+
+// L1Client creates the client connected to L1 network of the node.
+func (n *Node) L1Client() *ethclient.Client {
+	return ethclient.NewClient(dial(n.l1Server))
+}
+
+// Close stops serving the requests of the clients.
+func (n *Node) Close() {
+	n.l2Server.Stop()
+	n.l1Server.Stop()
+}
+
+// SetBalance sets the balance of the base token of the account on L2 network.
+func (n *Node) SetBalance(account common.Address, balance *big.Int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.balances[account] = new(big.Int).Set(balance)
+}
+
+// Balance returns the balance of the base token of the account on L2 network.
+func (n *Node) Balance(account common.Address) *big.Int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.balance(account)
+}
+
+// L1Balance returns the ETH balance of the account on L1 network, which is increased by the finalized withdrawals.
+func (n *Node) L1Balance(account common.Address) *big.Int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.l1.balance(account)
+}
+
+// AddToken deploys the ERC20 token at the given address on L2 network.
+func (n *Node) AddToken(token common.Address) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if _, ok := n.tokens[token]; !ok {
+		n.tokens[token] = make(map[common.Address]*big.Int)
+	}
+}
+
+// SetTokenBalance sets the balance of the token of the account on L2 network, deploying the token if needed.
+func (n *Node) SetTokenBalance(token, account common.Address, balance *big.Int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if _, ok := n.tokens[token]; !ok {
+		n.tokens[token] = make(map[common.Address]*big.Int)
+	}
+	n.tokens[token][account] = new(big.Int).Set(balance)
+}
+
+// TokenBalance returns the balance of the token of the account on L2 network.
+func (n *Node) TokenBalance(token, account common.Address) *big.Int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.tokenBalance(token, account)
+}
+
+// SealBatch seals the open batch and opens a new one. It returns the number of the sealed batch.
+func (n *Node) SealBatch() uint64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	b := n.openBatch()
+	b.status = BatchStatusSealed
+	b.timestamp = uint64(time.Now().Unix())
+	b.root = merkleRoot(b.leaves())
+	n.batches = append(n.batches, &batch{number: b.number + 1})
+	return b.number
+}
+
+// CommitBatch commits the sealed batch on L1 network.
+func (n *Node) CommitBatch(number uint64) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	_, err := n.commitBatch(number)
+	return err
+}
+
+// ProveBatch proves the sealed batch on L1 network, committing it first if needed.
+func (n *Node) ProveBatch(number uint64) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	_, err := n.proveBatch(number)
+	return err
+}
+
+// ExecuteBatch executes the sealed batch on L1 network, committing and proving it first if needed.
+// Once executed, the batch is verified and the root of its L2 -> L1 logs is known to the main contract.
+func (n *Node) ExecuteBatch(number uint64) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	b, err := n.proveBatch(number)
+	if err != nil {
+		return err
+	}
+	if b.executed == (common.Hash{}) {
+		b.executed = n.operatorTxHash()
+		b.status = BatchStatusVerified
+	}
+	return nil
+}
+
+// L1Transactions returns the transactions sent to L1 network, in the order they were sent.
+func (n *Node) L1Transactions() []*types.Transaction {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]*types.Transaction(nil), n.l1.txs...)
+}
+
+func (n *Node) commitBatch(number uint64) (*batch, error) {
+	if number == 0 || number >= uint64(len(n.batches)) {
+		return nil, fmt.Errorf("batch %d does not exist", number)
+	}
+	b := n.batches[number]
+	if b.status == "" {
+		return nil, fmt.Errorf("batch %d is not sealed", number)
+	}
+	if b.committed == (common.Hash{}) {
+		b.committed = n.operatorTxHash()
+	}
+	return b, nil
+}
+
+func (n *Node) proveBatch(number uint64) (*batch, error) {
+	b, err := n.commitBatch(number)
+	if err != nil {
+		return nil, err
+	}
+	if b.proven == (common.Hash{}) {
+		b.proven = n.operatorTxHash()
+	}
+	return b, nil
+}
+
+// operatorTxHash returns the hash of the next transaction sent by the operator to L1 network.
+// The operator transactions only identify the stages of the batches, they are not served by L1 network.
+func (n *Node) operatorTxHash() common.Hash {
+	n.l1.operatorTxs++
+	return crypto.Keccak256Hash([]byte("operator"), new(big.Int).SetUint64(n.l1.operatorTxs).Bytes())
+}
+
+// dial connects the client to the server in-process. Unlike rpc.DialInProc, it serves the zks_L1BatchNumber
+// and zks_L1ChainId methods, which can not be registered with rpc.Server since it lowercases the first letter of
+// the method names.
+func dial(server *rpc.Server) *rpc.Client {
+	c, err := rpc.DialOptions(context.Background(), "http://zksynctest", rpc.WithHTTPClient(&http.Client{
+		Transport: inProcTransport{server},
+	}))
+	if err != nil {
+		panic(fmt.Sprintf("failed to dial in-process: %v", err))
+	}
+	return c
+}
+
+// inProcTransport is the http.RoundTripper which serves the JSON-RPC requests with the server in-process.
+type inProcTransport struct {
+	server *rpc.Server
+}
+
+func (t inProcTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	body = lowercaseMethods(body)
+	r := req.Clone(req.Context())
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	recorder := httptest.NewRecorder()
+	t.server.ServeHTTP(recorder, r)
+	return recorder.Result(), nil
+}
+
+// lowercaseMethods lowercases the first letter of the method names, after the namespace, in the JSON-RPC request
+// or batch of requests.
+func lowercaseMethods(raw json.RawMessage) json.RawMessage {
+	isBatch := true
+	var msgs []map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &msgs); err != nil {
+		var msg map[string]json.RawMessage
+		if err = json.Unmarshal(raw, &msg); err != nil {
+			return raw
+		}
+		isBatch, msgs = false, []map[string]json.RawMessage{msg}
+	}
+	for _, msg := range msgs {
+		var method string
+		if err := json.Unmarshal(msg["method"], &method); err != nil {
+			continue
+		}
+		namespace, name, ok := strings.Cut(method, "_")
+		if !ok || name == "" || !unicode.IsUpper(rune(name[0])) {
+			continue
+		}
+		msg["method"], _ = json.Marshal(namespace + "_" + strings.ToLower(name[:1]) + name[1:])
+	}
+	var (
+		rewritten []byte
+		err       error
+	)
+	if isBatch {
+		rewritten, err = json.Marshal(msgs)
+	} else {
+		rewritten, err = json.Marshal(msgs[0])
+	}
+	if err != nil {
+		return raw
+	}
+	return rewritten
+}
+
+// netAPI serves the net_ namespace.
+type netAPI struct {
+	chainID int64
+}
+
+func (api *netAPI) Version() string {
+	return fmt.Sprint(api.chainID)
+}
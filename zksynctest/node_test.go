@@ -0,0 +1,82 @@
+package zksynctest
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+	"testing"
+)
+
+func TestNode(t *testing.T) {
+	ctx := context.Background()
+	node := NewNode(Config{})
+	defer node.Close()
+	client := node.Client()
+
+	chainID, err := client.ChainID(ctx)
+	assert.NoError(t, err, "ChainID should not return error")
+	assert.Equal(t, big.NewInt(270), chainID, "Chain IDs should be the same")
+	l1ChainID, err := client.L1ChainID(ctx)
+	assert.NoError(t, err, "L1ChainID should not return error")
+	assert.Equal(t, big.NewInt(9), l1ChainID, "L1 chain IDs should be the same")
+
+	key, err := crypto.GenerateKey()
+	assert.NoError(t, err, "GenerateKey should not return error")
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	receiver := common.HexToAddress("0xa61464658AfeAf65CccaaFD3a512b69A83B77618")
+	node.SetBalance(sender, big.NewInt(1_000_000_000_000_000))
+
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		GasFeeCap: big.NewInt(100_000_000),
+		Gas:       200_000,
+		To:        &receiver,
+		Value:     big.NewInt(1_000),
+	})
+	assert.NoError(t, err, "SignNewTx should not return error")
+	assert.NoError(t, client.SendTransaction(ctx, tx), "SendTransaction should not return error")
+	assert.Error(t, client.SendTransaction(ctx, tx), "SendTransaction should return error for known transaction")
+
+	receipt, err := client.TransactionReceipt(ctx, tx.Hash())
+	assert.NoError(t, err, "TransactionReceipt should not return error")
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status, "Transaction should succeed")
+	assert.Nil(t, receipt.L1BatchNumber, "Batch number should not be provided before the batch is sealed")
+	assert.Equal(t, big.NewInt(1_000), node.Balance(receiver), "Balances should be the same")
+	assert.Equal(t, big.NewInt(1_000_000_000_000_000-1_000-15_000_000_000_000), node.Balance(sender), "Balances should be the same")
+
+	assert.Equal(t, uint64(1), node.SealBatch(), "Batch numbers should be the same")
+	l1BatchNumber, err := client.L1BatchNumber(ctx)
+	assert.NoError(t, err, "L1BatchNumber should not return error")
+	assert.Equal(t, big.NewInt(1), l1BatchNumber, "Batch numbers should be the same")
+	details, err := client.L1BatchDetails(ctx, big.NewInt(1))
+	assert.NoError(t, err, "L1BatchDetails should not return error")
+	assert.Equal(t, BatchStatusSealed, details.Status, "Statuses should be the same")
+	assert.Nil(t, details.CommitTxHash, "Batch should not be committed")
+
+	assert.NoError(t, node.ExecuteBatch(1), "ExecuteBatch should not return error")
+	assert.Error(t, node.ExecuteBatch(2), "ExecuteBatch should return error for open batch")
+	details, err = client.L1BatchDetails(ctx, big.NewInt(1))
+	assert.NoError(t, err, "L1BatchDetails should not return error")
+	assert.Equal(t, BatchStatusVerified, details.Status, "Statuses should be the same")
+	assert.NotNil(t, details.CommitTxHash, "Batch should be committed")
+	assert.NotNil(t, details.ProveTxHash, "Batch should be proven")
+	assert.NotNil(t, details.ExecuteTxHash, "Batch should be executed")
+	txDetails, err := client.TransactionDetails(ctx, tx.Hash())
+	assert.NoError(t, err, "TransactionDetails should not return error")
+	assert.Equal(t, "verified", txDetails.Status, "Statuses should be the same")
+}
+
+func TestMerkleProof(t *testing.T) {
+	leaves := []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")}
+	for i, leaf := range leaves {
+		root, proof := merkleProof(leaves, i)
+		assert.Len(t, proof, l2ToL1LogsTreeHeight, "Proof should contain the sibling of each level")
+		assert.Equal(t, root, utils.MerkleRootFromProof(leaf, i, proof), "Roots should be the same")
+	}
+	root, proof := merkleProof(nil, 0)
+	assert.Equal(t, root, utils.MerkleRootFromProof(emptySubtreeHashes[0], 0, proof), "Roots should be the same")
+}
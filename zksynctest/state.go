@@ -0,0 +1,421 @@
+package zksynctest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/zksync-sdk/zksync2-go/contracts/erc20"
+	"github.com/zksync-sdk/zksync2-go/contracts/ethtoken"
+	"github.com/zksync-sdk/zksync2-go/contracts/l1messenger"
+	"github.com/zksync-sdk/zksync2-go/contracts/zksync"
+	zkTypes "github.com/zksync-sdk/zksync2-go/types"
+	"github.com/zksync-sdk/zksync2-go/utils"
+	"math/big"
+	"time"
+)
+
+// l2ToL1LogsTreeHeight is the height of the Merkle tree of the L2 -> L1 logs of the batch.
+const l2ToL1LogsTreeHeight = 14
+
+var (
+	errExecutionReverted = errors.New("execution reverted")
+
+	erc20Abi       = mustAbi(erc20.IERC20MetaData)
+	ethTokenAbi    = mustAbi(ethtoken.IEthTokenMetaData)
+	l1MessengerAbi = mustAbi(l1messenger.IL1MessengerMetaData)
+	zkSyncAbi      = mustAbi(zksync.IZkSyncMetaData)
+
+	// emptySubtreeHashes holds the roots of the empty subtrees of the Merkle tree of the L2 -> L1 logs,
+	// indexed by the height of the subtree.
+	emptySubtreeHashes = func() []common.Hash {
+		hashes := []common.Hash{crypto.Keccak256Hash(make([]byte, 88))}
+		for i := 1; i <= l2ToL1LogsTreeHeight; i++ {
+			hashes = append(hashes, crypto.Keccak256Hash(hashes[i-1].Bytes(), hashes[i-1].Bytes()))
+		}
+		return hashes
+	}()
+)
+
+// transaction is the L2 transaction executed by the node.
+type transaction struct {
+	hash       common.Hash
+	txType     uint8
+	from       common.Address
+	to         *common.Address
+	nonce      uint64
+	value      *big.Int
+	data       []byte
+	gas        uint64
+	gasFeeCap  *big.Int
+	gasTipCap  *big.Int
+	fee        *big.Int
+	status     uint64
+	block      *block
+	index      uint // The index of the transaction in the batch.
+	logs       []*types.Log
+	l2ToL1Logs []*l2ToL1Log
+	receivedAt time.Time
+}
+
+// block is the L2 block, which contains at most one transaction.
+type block struct {
+	header *types.Header
+	batch  *batch
+	tx     *transaction
+}
+
+// batch is the L2 batch, whose status is empty while it is open.
+type batch struct {
+	number    uint64
+	status    string
+	timestamp uint64
+	blocks    []*block
+	txs       []*transaction
+	logs      []*l2ToL1Log
+	root      common.Hash
+	committed common.Hash
+	proven    common.Hash
+	executed  common.Hash
+}
+
+// l2ToL1Log is the L2 -> L1 log sent by the L1Messenger, which commits to the message of the sender.
+type l2ToL1Log struct {
+	tx      *transaction
+	index   int // The index of the log in the batch.
+	sender  common.Address
+	message []byte
+}
+
+// effects are the changes of the state made by the successful execution of the transaction.
+type effects struct {
+	burn     bool // Whether the value is burned instead of being credited to the recipient.
+	transfer *tokenTransfer
+	logs     []*types.Log
+	messages []*l2ToL1Log
+}
+
+type tokenTransfer struct {
+	token, from, to common.Address
+	amount          *big.Int
+}
+
+// leaves returns the leaves of the Merkle tree of the L2 -> L1 logs of the batch.
+func (b *batch) leaves() []common.Hash {
+	leaves := make([]common.Hash, len(b.logs))
+	for i, l := range b.logs {
+		leaves[i] = l.leaf()
+	}
+	return leaves
+}
+
+func (l *l2ToL1Log) l2ToL1Log() *zkTypes.L2ToL1Log {
+	txIndex := hexutil.Uint(l.tx.index)
+	shardId := hexutil.Uint(0)
+	return &zkTypes.L2ToL1Log{
+		ShardId:          &shardId,
+		IsService:        true,
+		TxIndexInL1Batch: &txIndex,
+		Sender:           utils.L1MessengerAddress,
+		Key:              common.BytesToHash(l.sender.Bytes()).String(),
+		Value:            crypto.Keccak256Hash(l.message).String(),
+		TxHash:           l.tx.hash,
+	}
+}
+
+func (l *l2ToL1Log) leaf() common.Hash {
+	leaf, err := utils.L2ToL1LogLeaf(l.l2ToL1Log())
+	if err != nil {
+		panic(fmt.Sprintf("failed to compute leaf: %v", err))
+	}
+	return leaf
+}
+
+// merkleRoot returns the root of the Merkle tree of the L2 -> L1 logs with the given leaves.
+func merkleRoot(leaves []common.Hash) common.Hash {
+	root, _ := merkleProof(leaves, 0)
+	return root
+}
+
+// merkleProof returns the root of the Merkle tree of the L2 -> L1 logs with the given leaves, along with
+// the proof of the leaf at the given index, as expected by utils.MerkleRootFromProof.
+func merkleProof(leaves []common.Hash, index int) (common.Hash, []common.Hash) {
+	level := append([]common.Hash(nil), leaves...)
+	proof := make([]common.Hash, 0, l2ToL1LogsTreeHeight)
+	for height := 0; height < l2ToL1LogsTreeHeight; height++ {
+		if len(level)%2 == 1 {
+			level = append(level, emptySubtreeHashes[height])
+		}
+		if index^1 < len(level) {
+			proof = append(proof, level[index^1])
+		} else {
+			proof = append(proof, emptySubtreeHashes[height])
+		}
+		next := make([]common.Hash, len(level)/2)
+		for i := range next {
+			next[i] = crypto.Keccak256Hash(level[2*i].Bytes(), level[2*i+1].Bytes())
+		}
+		level, index = next, index/2
+	}
+	if len(level) == 0 {
+		return emptySubtreeHashes[l2ToL1LogsTreeHeight], proof
+	}
+	return level[0], proof
+}
+
+func (n *Node) balance(account common.Address) *big.Int {
+	if balance, ok := n.balances[account]; ok {
+		return new(big.Int).Set(balance)
+	}
+	return big.NewInt(0)
+}
+
+func (n *Node) tokenBalance(token, account common.Address) *big.Int {
+	if balance, ok := n.tokens[token][account]; ok {
+		return new(big.Int).Set(balance)
+	}
+	return big.NewInt(0)
+}
+
+func (n *Node) addBalance(account common.Address, amount *big.Int) {
+	n.balances[account] = new(big.Int).Add(n.balance(account), amount)
+}
+
+func (n *Node) openBatch() *batch {
+	return n.batches[len(n.batches)-1]
+}
+
+func (n *Node) latestBlock() *block {
+	return n.blocks[len(n.blocks)-1]
+}
+
+// finalizedBlock returns the latest block of the executed batches.
+func (n *Node) finalizedBlock() *block {
+	for i := len(n.batches) - 1; i >= 0; i-- {
+		if b := n.batches[i]; b.executed != (common.Hash{}) && len(b.blocks) > 0 {
+			return b.blocks[len(b.blocks)-1]
+		}
+	}
+	return n.blocks[0]
+}
+
+// sealedBatchNumber returns the number of the latest sealed batch.
+func (n *Node) sealedBatchNumber() uint64 {
+	return n.openBatch().number - 1
+}
+
+// isContract reports whether the code is deployed at the address on L2 network.
+func (n *Node) isContract(address common.Address) bool {
+	if _, ok := n.tokens[address]; ok {
+		return true
+	}
+	switch address {
+	case utils.L1MessengerAddress, utils.L2EthTokenAddress, L2Erc20DefaultBridgeAddress, TestnetPaymasterAddress:
+		return true
+	default:
+		return false
+	}
+}
+
+// newBlock appends the block containing the transaction to the open batch.
+func (n *Node) newBlock(tx *transaction) *block {
+	b := n.openBatch()
+	header := &types.Header{
+		UncleHash:   types.EmptyUncleHash,
+		Coinbase:    utils.BootloaderFormalAddress,
+		Root:        types.EmptyRootHash,
+		TxHash:      types.EmptyTxsHash,
+		ReceiptHash: types.EmptyReceiptsHash,
+		Difficulty:  big.NewInt(0),
+		Number:      big.NewInt(int64(len(n.blocks))),
+		GasLimit:    1 << 32,
+		Time:        uint64(time.Now().Unix()),
+		BaseFee:     new(big.Int).Set(n.config.GasPrice),
+	}
+	if len(n.blocks) > 0 {
+		header.ParentHash = n.latestBlock().header.Hash()
+	}
+	if tx != nil {
+		header.TxHash = crypto.Keccak256Hash(tx.hash.Bytes())
+		header.GasUsed = n.config.GasUsed
+	}
+	blk := &block{header: header, batch: b, tx: tx}
+	n.blocks = append(n.blocks, blk)
+	b.blocks = append(b.blocks, blk)
+	if tx != nil {
+		tx.block = blk
+		tx.index = uint(len(b.txs))
+		b.txs = append(b.txs, tx)
+		for _, l := range tx.l2ToL1Logs {
+			l.index = len(b.logs)
+			b.logs = append(b.logs, l)
+		}
+	}
+	return blk
+}
+
+// execute executes the call of the transaction. It does not change the state, but returns the effects of
+// the successful execution, or an error if the execution reverts.
+func (n *Node) execute(from common.Address, to *common.Address, value *big.Int, data []byte) (*effects, error) {
+	if to == nil {
+		return nil, errors.New("contract deployment is not supported")
+	}
+	if value.Cmp(n.balance(from)) > 0 {
+		return nil, errors.New("insufficient balance for transfer")
+	}
+	fx, err := n.executeCall(from, *to, value, data)
+	if err != nil {
+		return nil, err
+	}
+	if !fx.burn && value.Sign() > 0 {
+		fx.logs = append([]*types.Log{transferLog(utils.L2BaseTokenAddress, from, *to, value)}, fx.logs...)
+	}
+	return fx, nil
+}
+
+// executeCall executes the call of the contract, or the plain transfer if the recipient is not a contract.
+func (n *Node) executeCall(from, to common.Address, value *big.Int, data []byte) (*effects, error) {
+	fx := &effects{}
+	if len(data) == 0 && !n.isContract(to) {
+		return fx, nil
+	}
+	switch {
+	case to == utils.L2EthTokenAddress:
+		method, args, err := unpackCall(ethTokenAbi, data)
+		if err != nil || method.Name != "withdraw" {
+			return nil, errExecutionReverted
+		}
+		receiver := args[0].(common.Address)
+		message := append(append(zkSyncAbi.Methods["finalizeEthWithdrawal"].ID, receiver.Bytes()...),
+			common.LeftPadBytes(value.Bytes(), 32)...)
+		withdrawalEvent := ethTokenAbi.Events["Withdrawal"]
+		withdrawalData, err := withdrawalEvent.Inputs.NonIndexed().Pack(value)
+		if err != nil {
+			return nil, err
+		}
+		fx.burn = true
+		fx.logs = append(fx.logs, &types.Log{
+			Address: utils.L2EthTokenAddress,
+			Topics:  []common.Hash{withdrawalEvent.ID, common.BytesToHash(from.Bytes()), common.BytesToHash(receiver.Bytes())},
+			Data:    withdrawalData,
+		})
+		return fx, fx.sendToL1(utils.L2EthTokenAddress, message)
+	case to == utils.L1MessengerAddress:
+		method, args, err := unpackCall(l1MessengerAbi, data)
+		if err != nil || method.Name != "sendToL1" {
+			return nil, errExecutionReverted
+		}
+		return fx, fx.sendToL1(from, args[0].([]byte))
+	case n.tokens[to] != nil:
+		method, args, err := unpackCall(erc20Abi, data)
+		if err != nil || method.Name != "transfer" {
+			return nil, errExecutionReverted
+		}
+		recipient, amount := args[0].(common.Address), args[1].(*big.Int)
+		if amount.Cmp(n.tokenBalance(to, from)) > 0 {
+			return nil, fmt.Errorf("%w: transfer amount exceeds balance", errExecutionReverted)
+		}
+		fx.transfer = &tokenTransfer{token: to, from: from, to: recipient, amount: amount}
+		fx.logs = append(fx.logs, transferLog(to, from, recipient, amount))
+		return fx, nil
+	case n.isContract(to):
+		return nil, errExecutionReverted
+	default:
+		return fx, nil
+	}
+}
+
+// sendToL1 adds the L1MessageSent event and the L2 -> L1 log of the message sent by the sender.
+func (fx *effects) sendToL1(sender common.Address, message []byte) error {
+	event := l1MessengerAbi.Events["L1MessageSent"]
+	data, err := event.Inputs.NonIndexed().Pack(message)
+	if err != nil {
+		return err
+	}
+	fx.logs = append(fx.logs, &types.Log{
+		Address: utils.L1MessengerAddress,
+		Topics:  []common.Hash{event.ID, common.BytesToHash(sender.Bytes()), crypto.Keccak256Hash(message)},
+		Data:    data,
+	})
+	fx.messages = append(fx.messages, &l2ToL1Log{sender: sender, message: message})
+	return nil
+}
+
+// apply applies the effects of the transaction which transfers the value from the sender to the recipient.
+func (n *Node) apply(fx *effects, from common.Address, to common.Address, value *big.Int) {
+	n.addBalance(from, new(big.Int).Neg(value))
+	if !fx.burn {
+		n.addBalance(to, value)
+	}
+	if t := fx.transfer; t != nil {
+		n.tokens[t.token][t.from] = new(big.Int).Sub(n.tokenBalance(t.token, t.from), t.amount)
+		n.tokens[t.token][t.to] = new(big.Int).Add(n.tokenBalance(t.token, t.to), t.amount)
+	}
+}
+
+// sendTransaction executes the transaction and includes it in the new block of the open batch.
+func (n *Node) sendTransaction(tx *transaction) error {
+	if _, ok := n.txs[tx.hash]; ok {
+		return errors.New("known transaction")
+	}
+	if nonce := n.nonces[tx.from]; tx.nonce < nonce {
+		return fmt.Errorf("nonce too low: next nonce %d, tx nonce %d", nonce, tx.nonce)
+	} else if tx.nonce > nonce {
+		return fmt.Errorf("nonce too high: next nonce %d, tx nonce %d", nonce, tx.nonce)
+	}
+	tx.fee = new(big.Int).Mul(new(big.Int).SetUint64(n.config.GasUsed), n.config.GasPrice)
+	if new(big.Int).Add(tx.fee, tx.value).Cmp(n.balance(tx.from)) > 0 {
+		return errors.New("insufficient funds for gas * price + value")
+	}
+
+	n.addBalance(tx.from, new(big.Int).Neg(tx.fee))
+	n.nonces[tx.from]++
+	tx.status = types.ReceiptStatusFailed
+	if fx, err := n.execute(tx.from, tx.to, tx.value, tx.data); err == nil {
+		n.apply(fx, tx.from, *tx.to, tx.value)
+		tx.status = types.ReceiptStatusSuccessful
+		tx.logs = fx.logs
+		tx.l2ToL1Logs = fx.messages
+		for _, l := range tx.l2ToL1Logs {
+			l.tx = tx
+		}
+	}
+	n.txs[tx.hash] = tx
+	n.newBlock(tx)
+	return nil
+}
+
+func transferLog(token, from, to common.Address, amount *big.Int) *types.Log {
+	return &types.Log{
+		Address: token,
+		Topics:  []common.Hash{erc20Abi.Events["Transfer"].ID, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+		Data:    common.LeftPadBytes(amount.Bytes(), 32),
+	}
+}
+
+func unpackCall(contractAbi *abi.ABI, data []byte) (*abi.Method, []interface{}, error) {
+	if len(data) < 4 {
+		return nil, nil, errors.New("missing method selector")
+	}
+	method, err := contractAbi.MethodById(data[:4])
+	if err != nil {
+		return nil, nil, err
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return method, args, nil
+}
+
+func mustAbi(metaData *bind.MetaData) *abi.ABI {
+	contractAbi, err := metaData.GetAbi()
+	if err != nil {
+		panic(fmt.Sprintf("failed to load ABI: %v", err))
+	}
+	return contractAbi
+}